@@ -0,0 +1,211 @@
+package command
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/darrenparkinson/bcs/pkg/ciscobcs"
+	"github.com/mitchellh/cli"
+)
+
+// AuditCommand is the top level struct for the cli AuditCommand.
+// It holds a reference to the cli.Ui for logging etc.
+type AuditCommand struct {
+	Ui cli.Ui
+}
+
+// Help provies the help text for this command.
+func (c *AuditCommand) Help() string {
+	helpText := `
+Usage: bcs-cli [global options] audit [options]
+
+  Audit the reachability and freshness of device data.
+
+  Reports the devices in a bulk data file which are not reachable,
+  whose inventory or config collection has not completed, or whose
+  inventory or config was last collected more than the given number
+  of days ago, grouped by collector.
+
+  Devices can also be tagged using a rules file, to audit each
+  site, team or business unit separately, e.g.
+
+    bcs-cli audit -tags=tags.yaml -tag=site
+
+Options:
+  -filename=FILENAME  Specify the filename for the jsonlines
+                      file to audit.
+
+  -days=N             Report data collected more than N days ago
+                      as stale. Default 7, 0 to skip.
+
+  -ref=DATE           The date to assess staleness against, as
+                      YYYY-MM-DD. Default today.
+
+  -tags=FILENAME      Specify a YAML or JSON file of rules to tag
+                      the devices with.
+
+  -tag=NAME           Audit the devices for each value of the named
+                      tag separately. Requires -tags.
+
+  -summary            Output the counts for each collector only.
+
+  -format=FORMAT      Output as text or json, with ages in
+                      seconds. Default text.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+// Run provides the command functionality
+func (c *AuditCommand) Run(args []string) int {
+	var filename, refDate, tagsFilename, tag, format string
+	var days int
+	var summary bool
+
+	cmdFlags := flag.NewFlagSet("audit", flag.ContinueOnError)
+	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	cmdFlags.StringVar(&filename, "filename", "bcs_bulk.jsonl", "filename of bulk download file to audit")
+	cmdFlags.IntVar(&days, "days", 7, "number of days after which data is stale")
+	cmdFlags.StringVar(&refDate, "ref", "", "date to assess staleness against")
+	cmdFlags.StringVar(&tagsFilename, "tags", "", "filename of rules to tag devices with")
+	cmdFlags.StringVar(&tag, "tag", "", "tag to audit the devices for each value of")
+	cmdFlags.BoolVar(&summary, "summary", false, "output the counts for each collector only")
+	cmdFlags.StringVar(&format, "format", "text", "output format: text or json")
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	if format != "text" && format != "json" {
+		c.Ui.Error(fmt.Sprintf("unsupported format: %s", format))
+		return 1
+	}
+	ref := time.Now()
+	if refDate != "" {
+		t, err := time.ParseInLocation("2006-01-02", refDate, ciscobcs.Location)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("invalid ref date: %s", refDate))
+			return 1
+		}
+		ref = t
+	}
+
+	if tag != "" && tagsFilename == "" {
+		c.Ui.Error("-tag requires -tags")
+		return 1
+	}
+	var rules *ciscobcs.TagRules
+	if tagsFilename != "" {
+		var err error
+		if rules, err = ciscobcs.LoadTagRules(tagsFilename); err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+	}
+
+	results, err := ciscobcs.ParseBulkFile(filename)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	groups := map[string][]ciscobcs.Device{"": results.Devices}
+	if rules != nil {
+		results.ApplyTagRules(rules)
+		if tag != "" {
+			groups = results.Tags.Group(results.Devices, tag)
+		}
+	}
+	values := make([]string, 0, len(groups))
+	for v := range groups {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	audits := make([]taggedAudit, len(values))
+	for i, v := range values {
+		a := ciscobcs.AuditDevices(groups[v], ref, time.Duration(days)*24*time.Hour)
+		if summary {
+			for j := range a.Collectors {
+				a.Collectors[j].Findings = nil
+			}
+		}
+		audits[i] = taggedAudit{Tag: tag, Value: v, Audit: a}
+	}
+
+	if format == "json" {
+		var v interface{} = audits[0].Audit
+		if tag != "" {
+			v = audits
+		}
+		b, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		c.Ui.Output(string(b))
+		return 0
+	}
+	for _, a := range audits {
+		c.outputAudit(a, results.Tags, rules)
+	}
+	return 0
+}
+
+// taggedAudit is the audit of the devices with a single value of a tag.
+type taggedAudit struct {
+	Tag   string                `json:"tag"`
+	Value string                `json:"value"`
+	Audit *ciscobcs.DeviceAudit `json:"audit"`
+}
+
+// outputAudit outputs the counts for each collector followed by the findings for each device,
+// along with any tags.
+func (c *AuditCommand) outputAudit(a taggedAudit, tags ciscobcs.DeviceTags, rules *ciscobcs.TagRules) {
+	prefix := ""
+	if a.Tag != "" {
+		value := a.Value
+		if value == "" {
+			value = "(none)"
+		}
+		prefix = fmt.Sprintf("%s %s, ", a.Tag, value)
+	}
+	c.Ui.Info(fmt.Sprintf("%s%d devices audited:%s", prefix, a.Audit.Devices, counts(a.Audit.Counts)))
+	for _, ca := range a.Audit.Collectors {
+		c.Ui.Info(fmt.Sprintf("%scollector %s, %d devices:%s", prefix, ca.Collector, ca.Devices, counts(ca.Counts)))
+		for _, f := range ca.Findings {
+			line := fmt.Sprintf("  %d %s: %s", f.Device.GetDeviceId(), f.Device.GetDeviceName(), strings.Join(f.Issues, ", "))
+			if rules != nil {
+				var t []string
+				for _, name := range rules.Names() {
+					if v := tags.Tag(&f.Device, name); v != "" {
+						t = append(t, name+"="+v)
+					}
+				}
+				if len(t) > 0 {
+					line += " [" + strings.Join(t, " ") + "]"
+				}
+			}
+			c.Ui.Output(line)
+		}
+	}
+}
+
+// counts returns the number of devices with each issue, in the order the issues are reported.
+func counts(issues map[string]int) string {
+	var b strings.Builder
+	for _, issue := range ciscobcs.AuditIssues {
+		if n := issues[issue]; n > 0 {
+			fmt.Fprintf(&b, " %s %d", issue, n)
+		}
+	}
+	if b.Len() == 0 {
+		return " no issues"
+	}
+	return b.String()
+}
+
+// Synopsis provides the one liner
+func (c *AuditCommand) Synopsis() string {
+	return "Audit the reachability and freshness of device data."
+}
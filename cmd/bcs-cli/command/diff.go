@@ -0,0 +1,141 @@
+package command
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/darrenparkinson/bcs/pkg/ciscobcs"
+	"github.com/mitchellh/cli"
+)
+
+// DiffCommand is the top level struct for the cli DiffCommand.
+// It holds a reference to the cli.Ui for logging etc.
+type DiffCommand struct {
+	Ui cli.Ui
+}
+
+// Help provies the help text for this command.
+func (c *DiffCommand) Help() string {
+	helpText := `
+Usage: bcs-cli [global options] diff [options]
+
+  Show the differences between two bulk data files.
+
+  Compares two bulk data files, e.g. from consecutive daily
+  downloads, and reports the devices added, removed or changed,
+  the new and updated PSIRT and field notice bulletins and any
+  changes to the software track recommendations.
+
+Options:
+  -old=FILENAME       The earlier jsonlines file. Required.
+
+  -new=FILENAME       The later jsonlines file. Required.
+
+  -format=FORMAT      Output as text or json. Default text.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+// Run provides the command functionality
+func (c *DiffCommand) Run(args []string) int {
+	var oldFilename, newFilename, format string
+
+	cmdFlags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	cmdFlags.StringVar(&oldFilename, "old", "", "earlier bulk download file")
+	cmdFlags.StringVar(&newFilename, "new", "", "later bulk download file")
+	cmdFlags.StringVar(&format, "format", "text", "output format: text or json")
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	if oldFilename == "" || newFilename == "" {
+		c.Ui.Error("both -old and -new are required")
+		return 1
+	}
+	if format != "text" && format != "json" {
+		c.Ui.Error(fmt.Sprintf("unsupported format: %s", format))
+		return 1
+	}
+
+	prev, err := ciscobcs.ParseBulkFile(oldFilename)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	next, err := ciscobcs.ParseBulkFile(newFilename)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	d := ciscobcs.DiffBulk(prev, next)
+
+	if format == "json" {
+		b, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		c.Ui.Output(string(b))
+		return 0
+	}
+	if d.Empty() {
+		c.Ui.Info("no differences")
+		return 0
+	}
+	for _, v := range d.AddedDevices {
+		c.Ui.Output(fmt.Sprintf("+ device %d %s", v.GetDeviceId(), v.GetDeviceName()))
+	}
+	for _, v := range d.RemovedDevices {
+		c.Ui.Output(fmt.Sprintf("- device %d %s", v.GetDeviceId(), v.GetDeviceName()))
+	}
+	for _, v := range d.ChangedDevices {
+		c.Ui.Output(fmt.Sprintf("~ device %d %s", v.DeviceId, v.Device.GetDeviceName()))
+		c.outputChanges(v.Changes)
+	}
+	for _, v := range d.NewPSIRTBulletins {
+		c.Ui.Output(fmt.Sprintf("+ psirt %s %s", v.GetPsirtAdvisoryId(), v.GetBulletinTitle()))
+	}
+	for _, v := range d.UpdatedPSIRTBulletins {
+		c.Ui.Output(fmt.Sprintf("~ psirt %s %s", v.Id, v.Title))
+		c.outputChanges(v.Changes)
+	}
+	for _, v := range d.NewFNBulletins {
+		c.Ui.Output(fmt.Sprintf("+ field notice %s %s", v.GetFieldNoticeId(), v.GetBulletinTitle()))
+	}
+	for _, v := range d.UpdatedFNBulletins {
+		c.Ui.Output(fmt.Sprintf("~ field notice %s %s", v.Id, v.Title))
+		c.outputChanges(v.Changes)
+	}
+	for _, v := range d.AddedTracks {
+		c.Ui.Output(fmt.Sprintf("+ track %d %s", v.GetTrackId(), v.GetTrackName()))
+	}
+	for _, v := range d.RemovedTracks {
+		c.Ui.Output(fmt.Sprintf("- track %d %s", v.GetTrackId(), v.GetTrackName()))
+	}
+	for _, v := range d.ChangedTracks {
+		c.Ui.Output(fmt.Sprintf("~ track %d %s", v.TrackId, v.TrackName))
+		c.outputChanges(v.Changes)
+		for _, r := range v.AddedRecommendations {
+			c.Ui.Output(fmt.Sprintf("    + %s %s (%s)", r.GetSwRole(), r.GetSwName(), r.GetTrackRecHistory()))
+		}
+		for _, r := range v.RemovedRecommendations {
+			c.Ui.Output(fmt.Sprintf("    - %s %s (%s)", r.GetSwRole(), r.GetSwName(), r.GetTrackRecHistory()))
+		}
+	}
+	return 0
+}
+
+// outputChanges outputs each of the field changes on its own line.
+func (c *DiffCommand) outputChanges(changes []ciscobcs.FieldChange) {
+	for _, fc := range changes {
+		c.Ui.Output(fmt.Sprintf("    %s: %q -> %q", fc.Field, fc.Old, fc.New))
+	}
+}
+
+// Synopsis provides the one liner
+func (c *DiffCommand) Synopsis() string {
+	return "Show the differences between two bulk data files."
+}
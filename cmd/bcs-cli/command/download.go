@@ -3,8 +3,10 @@ package command
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/darrenparkinson/bcs/pkg/ciscobcs"
 	"github.com/mitchellh/cli"
@@ -31,7 +33,11 @@ Options:
   -apikey=KEY            The API Key to use for the download. Required.
 
   -filename=FILENAME  Specify the filename to save the jsonlines
-                      output to. Default bcs_bulk.jsonl.
+                      output to. Default bcs_bulk.jsonl, with .gz or
+                      .zst appended when compressing.
+
+  -compress=FORMAT    Compress the saved file using gzip or zstd.
+                      Default none.
 
 `
 	return strings.TrimSpace(helpText)
@@ -39,34 +45,87 @@ Options:
 
 // Run provides the command functionality
 func (c *DownloadCommand) Run(args []string) int {
-	var customerID, apikey, filename string
+	var customerID, apikey, filename, compress string
 	cmdFlags := flag.NewFlagSet("download", flag.ContinueOnError)
 	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
 	cmdFlags.StringVar(&customerID, "id", "280987866", "customer id (default demo customer)")
 	cmdFlags.StringVar(&apikey, "apikey", "lqWTZbApQZgSR52ag8NS9jc5STobf6hMjm3Kyf30", "api key with access to customer")
-	cmdFlags.StringVar(&filename, "filename", "bcs_bulk.jsonl", "download file to create")
+	cmdFlags.StringVar(&filename, "filename", "", "download file to create")
+	cmdFlags.StringVar(&compress, "compress", "none", "compression format: none, gzip or zstd")
 	//TODO: Add separate or raw option and maybe download separate files
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
-	bcs, err := ciscobcs.NewClient(apikey, nil)
+	compression, err := ciscobcs.ParseCompression(compress)
 	if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
-	file, err := os.Create(filename)
+	if filename == "" {
+		filename = "bcs_bulk.jsonl" + compression.Extension()
+	}
+	bcs, err := ciscobcs.NewClient(apikey, nil)
 	if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
-	err = bcs.BulkService.Download(context.Background(), customerID, file)
+	opts := &ciscobcs.DownloadOptions{Compression: compression}
+	inProgress := false
+	if isTerminal(os.Stdout) {
+		opts.Progress = func(p ciscobcs.DownloadProgress) {
+			inProgress = !p.Done
+			fmt.Fprintf(os.Stdout, "\r%s", progressLine(p))
+			if p.Done {
+				fmt.Fprintln(os.Stdout)
+			}
+		}
+		opts.ProgressInterval = 200 * time.Millisecond
+	} else {
+		opts.Progress = func(p ciscobcs.DownloadProgress) {
+			c.Ui.Info(progressLine(p))
+		}
+		opts.ProgressInterval = 10 * time.Second
+	}
+	summary, err := bcs.BulkService.DownloadFile(context.Background(), customerID, filename, opts)
 	if err != nil {
+		if inProgress {
+			// end the progress line, which is never reported as done for a failed download
+			fmt.Fprintln(os.Stdout)
+		}
 		c.Ui.Error(err.Error())
 		return 1
 	}
+	c.Ui.Info(fmt.Sprintf("%d lines (%d bytes) saved to %s", summary.Lines, summary.Bytes, filename))
 	return 0
 }
 
+// progressLine formats the download progress for display.
+func progressLine(p ciscobcs.DownloadProgress) string {
+	return fmt.Sprintf("downloaded %s, %d lines in %s (%s/s)",
+		formatBytes(float64(p.Bytes)), p.Lines, p.Elapsed.Round(time.Second), formatBytes(p.Rate))
+}
+
+// formatBytes formats a number of bytes using binary units, e.g. 1.5 MiB.
+func formatBytes(b float64) string {
+	const unit = 1024
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	i := 0
+	for b >= unit && i < len(units)-1 {
+		b /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f %s", b, units[i])
+}
+
+// isTerminal reports whether the file is attached to a terminal.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
 // Synopsis provides the one liner
 func (c *DownloadCommand) Synopsis() string {
 	return "Download raw data from the bulk endpoint and save as a file."
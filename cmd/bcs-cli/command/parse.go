@@ -1,9 +1,12 @@
 package command
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/darrenparkinson/bcs/pkg/ciscobcs"
 	"github.com/mitchellh/cli"
@@ -25,32 +28,119 @@ Usage: bcs-cli [global options] parse [options]
   After downloading a bulk data file, you can use this command
   to parse the file and provide stats including the number of
   lines processed, a count of each type and a list of any
-  unrecognised types. 
+  unrecognised types.  Probable duplicate devices, i.e. those with
+  the same product ID and either the same sysName or the same IP
+  address, are also reported.
+
+  The records are then grouped to show the most common values,
+  by default the devices by product family, software type,
+  software version, status and collector, the PSIRT bulletins by
+  SIR and the field notices by type.  PSIRT bulletins are listed
+  for each platform they affect, but are counted once for each
+  advisory.  Alternatively, specify the fields to group by, e.g.
+
+    bcs-cli parse -group-by=swType,swVersion -top=20
+
+  Devices can also be grouped by the tags derived from a rules
+  file, such as the site or team, e.g.
+
+    bcs-cli parse -tags=tags.yaml -group-by=tag:site,swVersion
 
 Options:
   -filename=FILENAME  Specify the filename for the jsonlines
                       file to process.
 
+  -workers=N          Decode the file across N workers.  Default 0
+                      which decodes sequentially.
+
+  -group-by=FIELDS    Comma separated fields to group the records
+                      by, e.g. swType,swVersion.  Default shows
+                      the standard groupings.
+
+  -tags=FILENAME      Specify a YAML or JSON file of rules to tag
+                      the devices with, adding a grouping for
+                      each tag to the standard groupings.
+
+  -type=TYPE          The type of record to group, e.g. device or
+                      psirt_bulletin. Default device.
+
+  -top=N              Show the N most common groups only, with the
+                      rest counted as other. Default 10, 0 for all.
+
+  -format=FORMAT      Output as text or json. Default text.
+
 `
 	return strings.TrimSpace(helpText)
 }
 
 // Run provides the command functionality
 func (c *ParseFileCommand) Run(args []string) int {
-	var filename string
+	var filename, groupBy, tagsFilename, bulkType, format string
+	var workers, top int
 
 	cmdFlags := flag.NewFlagSet("parse", flag.ContinueOnError)
 	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
 	cmdFlags.StringVar(&filename, "filename", "bcs_bulk.jsonl", "filename of bulk download file to parse")
+	cmdFlags.IntVar(&workers, "workers", 0, "number of workers to decode with")
+	cmdFlags.StringVar(&groupBy, "group-by", "", "comma separated fields to group by")
+	cmdFlags.StringVar(&tagsFilename, "tags", "", "filename of rules to tag devices with")
+	cmdFlags.StringVar(&bulkType, "type", "device", "type of record to group")
+	cmdFlags.IntVar(&top, "top", 10, "number of groups to show")
+	cmdFlags.StringVar(&format, "format", "text", "output format: text or json")
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
+	if format != "text" && format != "json" {
+		c.Ui.Error(fmt.Sprintf("unsupported format: %s", format))
+		return 1
+	}
+	var rules *ciscobcs.TagRules
+	if tagsFilename != "" {
+		var err error
+		if rules, err = ciscobcs.LoadTagRules(tagsFilename); err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+	}
+	groupings := append([]ciscobcs.Grouping(nil), ciscobcs.DefaultGroupings...)
+	if groupBy != "" {
+		fields := strings.Split(groupBy, ",")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		groupings = []ciscobcs.Grouping{{Type: bulkType, Fields: fields}}
+	} else if rules != nil {
+		for _, name := range rules.Names() {
+			groupings = append(groupings, ciscobcs.Grouping{Type: "device", Fields: []string{ciscobcs.TagFieldPrefix + name}})
+		}
+	}
 
-	results, err := ciscobcs.ParseBulkFile(filename)
+	var results *ciscobcs.BulkResults
+	var err error
+	if workers > 0 {
+		results, err = ciscobcs.ParseBulkFileWithOptions(context.Background(), filename, &ciscobcs.BulkOptions{Workers: workers})
+	} else {
+		results, err = ciscobcs.ParseBulkFile(filename)
+	}
 	if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
+	if rules != nil {
+		results.ApplyTagRules(rules)
+	}
+	stats, err := results.StatsFor(groupings)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	for i := range stats {
+		stats[i] = stats[i].Top(top)
+	}
+	if format == "json" {
+		return c.outputJSON(results, stats)
+	}
+
 	for _, e := range results.Errors {
 		c.Ui.Error(fmt.Sprintf("error in results: %s", e))
 	}
@@ -61,9 +151,67 @@ func (c *ParseFileCommand) Run(args []string) int {
 	for k, v := range results.CountOfTypes {
 		c.Ui.Info(fmt.Sprintf("  * %s: %d", k, v))
 	}
+	if r := results.ReconcileDevices(); r.Duplicates > 0 {
+		c.Ui.Warn(fmt.Sprintf("%d probable duplicate devices, %d unique devices", r.Duplicates, len(r.Devices)))
+	}
 	for k, v := range results.UnrecognisedTypes {
 		c.Ui.Warn(fmt.Sprintf("unrecognised type: %s: %d", k, v))
 	}
+	for _, s := range stats {
+		c.outputStats(s)
+	}
+	return 0
+}
+
+// outputStats outputs a table of the groups, with a column for each field grouped by.
+func (c *ParseFileCommand) outputStats(s *ciscobcs.GroupStats) {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "  %s\tCOUNT\t%%\n", strings.ToUpper(strings.Join(s.Fields, "\t")))
+	for _, g := range s.Groups {
+		values := make([]string, len(g.Values))
+		for i, v := range g.Values {
+			values[i] = v
+			if v == "" {
+				values[i] = "(none)"
+			}
+		}
+		fmt.Fprintf(tw, "  %s\t%d\t%.1f\n", strings.Join(values, "\t"), g.Count, g.Percent)
+	}
+	if s.Other > 0 {
+		fmt.Fprintf(tw, "  (other)%s\t%d\t%.1f\n", strings.Repeat("\t", len(s.Fields)-1), s.Other, 100*float64(s.Other)/float64(s.Total))
+	}
+	tw.Flush()
+	c.Ui.Info(fmt.Sprintf("\n%s (%d records):", s.Grouping, s.Total))
+	c.Ui.Output(strings.TrimRight(b.String(), "\n"))
+}
+
+// outputJSON outputs the stats along with the counts of each type.
+func (c *ParseFileCommand) outputJSON(results *ciscobcs.BulkResults, stats []*ciscobcs.GroupStats) int {
+	errors := make([]string, len(results.Errors))
+	for i, e := range results.Errors {
+		errors[i] = e.Error()
+	}
+	b, err := json.MarshalIndent(struct {
+		LineCount         int                    `json:"lineCount"`
+		CountOfTypes      map[string]int         `json:"countOfTypes"`
+		UnrecognisedTypes map[string]int         `json:"unrecognisedTypes,omitempty"`
+		Duplicates        int                    `json:"duplicates"`
+		Errors            []string               `json:"errors,omitempty"`
+		Stats             []*ciscobcs.GroupStats `json:"stats"`
+	}{
+		LineCount:         results.LineCount,
+		CountOfTypes:      results.CountOfTypes,
+		UnrecognisedTypes: results.UnrecognisedTypes,
+		Duplicates:        results.ReconcileDevices().Duplicates,
+		Errors:            errors,
+		Stats:             stats,
+	}, "", "  ")
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	c.Ui.Output(string(b))
 	return 0
 }
 
@@ -0,0 +1,176 @@
+package command
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/darrenparkinson/bcs/pkg/ciscobcs"
+	"github.com/mitchellh/cli"
+)
+
+// QueryCommand is the top level struct for the cli QueryCommand.
+// It holds a reference to the cli.Ui for logging etc.
+type QueryCommand struct {
+	Ui cli.Ui
+}
+
+// Help provies the help text for this command.
+func (c *QueryCommand) Help() string {
+	helpText := `
+Usage: bcs-cli [global options] query [options]
+
+  Query a bulk data file for matching records.
+
+  Outputs the records of the given type which match the filter
+  as jsonlines, in the same format as the bulk data file, so the
+  output can itself be parsed.  The filter uses the same JSON
+  syntax as the API, with % as a wildcard, e.g.
+
+    bcs-cli query -type device -filter '{"deviceName":"lon%"}'
+
+  The fields output can be limited with a mask, using the same
+  syntax as the API, e.g.
+
+    bcs-cli query -mask deviceName,productId -format csv
+
+  Devices can be tagged using a rules file, such as with the site
+  or team, adding a column for each tag to the CSV output, or the
+  tags to each device in the jsonlines output.
+
+Options:
+  -filename=FILENAME  Specify the filename for the jsonlines
+                      file to query.
+
+  -type=TYPE          The type of record to query, e.g. device,
+                      psirt_bulletin or fn_bulletin. Default device.
+
+  -filter=FILTER      The filter in JSON. Default matches every
+                      record of the type.
+
+  -mask=MASK          The fields to output, e.g. deviceName,swVersion.
+                      Default outputs every field.
+
+  -format=FORMAT      Output as jsonl or csv. Default jsonl.
+
+  -tags=FILENAME      Specify a YAML or JSON file of rules to tag
+                      the devices with.
+
+  -count              Output the number of matching records only.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+// Run provides the command functionality
+func (c *QueryCommand) Run(args []string) int {
+	var filename, bulkType, filter, mask, format, tagsFilename string
+	var count bool
+
+	cmdFlags := flag.NewFlagSet("query", flag.ContinueOnError)
+	cmdFlags.Usage = func() { c.Ui.Output(c.Help()) }
+	cmdFlags.StringVar(&filename, "filename", "bcs_bulk.jsonl", "filename of bulk download file to query")
+	cmdFlags.StringVar(&bulkType, "type", "device", "type of record to query")
+	cmdFlags.StringVar(&filter, "filter", "", "filter in JSON")
+	cmdFlags.StringVar(&mask, "mask", "", "fields to output")
+	cmdFlags.StringVar(&format, "format", "jsonl", "output format: jsonl or csv")
+	cmdFlags.StringVar(&tagsFilename, "tags", "", "filename of rules to tag devices with")
+	cmdFlags.BoolVar(&count, "count", false, "output the number of matching records only")
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	if format != "jsonl" && format != "csv" {
+		c.Ui.Error(fmt.Sprintf("unsupported format: %s", format))
+		return 1
+	}
+	f, err := ciscobcs.ParseFilter(filter)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	m, err := ciscobcs.ParseMask(mask)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	var rules *ciscobcs.TagRules
+	if tagsFilename != "" {
+		if rules, err = ciscobcs.LoadTagRules(tagsFilename); err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+	}
+	results, err := ciscobcs.ParseBulkFile(filename)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	var names []string
+	if rules != nil {
+		results.ApplyTagRules(rules)
+		names = rules.Names()
+	}
+	records, err := results.Query(bulkType, f)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if count {
+		c.Ui.Output(fmt.Sprint(len(records)))
+		return 0
+	}
+	if err := c.write(records, bulkType, m, format, results, names); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	return 0
+}
+
+// write outputs the records in the given format, with the fields selected by the mask and the
+// named tags of any devices.  Where every field is output, devices include their advisories
+// and field notices from the results, as in the bulk data.
+func (c *QueryCommand) write(records []interface{}, bulkType string, m ciscobcs.Mask, format string, results *ciscobcs.BulkResults, names []string) error {
+	tags := results.Tags
+	if format == "csv" {
+		cw := ciscobcs.NewCSVWriter(os.Stdout, m)
+		cw.SetTags(tags, names...)
+		for _, r := range records {
+			if err := cw.Write(r); err != nil {
+				return err
+			}
+		}
+		return cw.Flush()
+	}
+	if len(m) == 0 && len(names) == 0 {
+		bw := ciscobcs.NewBulkWriter(os.Stdout)
+		for _, r := range results.BulkRecords(records) {
+			if err := bw.Write(r); err != nil {
+				return err
+			}
+		}
+		return bw.Flush()
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for _, r := range records {
+		p, err := m.Project(r)
+		if err != nil {
+			return err
+		}
+		if d, ok := r.(ciscobcs.Device); ok && len(names) > 0 {
+			p["tags"] = tags[d.GetDeviceId()]
+		}
+		p["type"] = bulkType
+		if err := enc.Encode(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Synopsis provides the one liner
+func (c *QueryCommand) Synopsis() string {
+	return "Query a bulk data file for matching records."
+}
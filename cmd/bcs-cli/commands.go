@@ -20,12 +20,21 @@ func init() {
 		ErrorColor:  cli.UiColorRed,
 	}
 	Commands = map[string]cli.CommandFactory{
+		"audit": func() (cli.Command, error) {
+			return &command.AuditCommand{Ui: ui}, nil
+		},
+		"diff": func() (cli.Command, error) {
+			return &command.DiffCommand{Ui: ui}, nil
+		},
 		"download": func() (cli.Command, error) {
 			return &command.DownloadCommand{Ui: ui}, nil
 		},
 		"parse": func() (cli.Command, error) {
 			return &command.ParseFileCommand{Ui: ui}, nil
 		},
+		"query": func() (cli.Command, error) {
+			return &command.QueryCommand{Ui: ui}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &command.VersionCommand{
 				Revision:          GitCommit,
@@ -0,0 +1,542 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+var (
+	// typeNames maps the schema names in the specification to the model names used in the ciscobcs
+	// package, where they differ from the schema name with the first letter in upper case.  The models
+	// for the bulk data types were written by hand before they were generated, so they keep their names.
+	typeNames = map[string]string{
+		"CBPRules":            "CBPRule",
+		"CBPRulesReferences":  "CBPRuleReference",
+		"Error":               "ErrorResponse",
+		"FNBulletins":         "FNBulletin",
+		"HWEOXBulletins":      "HWEOXBulletin",
+		"PSIRTBulletins":      "PSIRTBulletin",
+		"SWEOXBulletins":      "SWEOXBulletin",
+		"collectorModel":      "Collector",
+		"complianceModel":     "TrackCompliance",
+		"feedbackModel":       "Feedback",
+		"fieldNotices":        "FieldNotice",
+		"pageOfResults":       "Page",
+		"recomendationsModel": "TrackSmupieRecommendation",
+		"securityAdvisories":  "SecurityAdvisory",
+		"softwareAlerts":      "SoftwareAlert",
+		"summaryModel":        "TrackSummary",
+	}
+
+	// fieldTypes overrides the type of properties which the specification declares as plain strings, but
+	// which hold a datetime in a number of different formats.
+	fieldTypes = map[string]string{
+		"bulletinFirstPublished": "Timestamp",
+	}
+
+	// operations maps each operation in the specification to the service and method name used for it.
+	// Operations without a method name are implemented by hand.  Every operation must be listed here,
+	// so that an operation added to the specification is not silently ignored.
+	operations = map[string]operation{
+		"get_bulk_inventory":   {Service: "BulkService"},
+		"get_details":          {Service: "ConfigurationBestPracticeService", Method: "ListDetails"},
+		"get_rules":            {Service: "ConfigurationBestPracticeService", Method: "ListRules"},
+		"get_rules_ref":        {Service: "ConfigurationBestPracticeService", Method: "ListRuleReferences"},
+		"get_summary":          {Service: "ConfigurationBestPracticeService", Method: "ListSummary"},
+		"get_collectors":       {Service: "CollectorsService", Method: "List"},
+		"get_serials":          {Service: "ContractService", Method: "ListSerialNumbers"},
+		"get_count":            {Service: "CountService", Method: "List"},
+		"get_crash_risk":       {Service: "CrashPreventionService", Method: "ListCrashRisks"},
+		"get_crash_risk_count": {Service: "CrashPreventionService", Method: "CountCrashRisks"},
+		"get_feedback_root":    {Service: "FeedbackService", Method: "List"},
+		"post_feedback_root":   {Service: "FeedbackService", Method: "Create", Body: "FeedbackRequest"},
+		"delete_feedback_id":   {Service: "FeedbackService", Method: "Delete"},
+		"get_assets":           {Service: "InventoryService", Method: "ListAssets"},
+		"get_assets_count":     {Service: "InventoryService", Method: "CountAssets"},
+		"get_device_sum":       {Service: "InventoryService", Method: "ListDevices"},
+		"get_device_sum_count": {Service: "InventoryService", Method: "CountDevices"},
+		"get_fn":               {Service: "ProductAlertService", Method: "ListFieldNotices"},
+		"get_fn__bulletins":    {Service: "ProductAlertService", Method: "ListFNBulletins"},
+		"get_hweox":            {Service: "ProductAlertService", Method: "ListHWEOX"},
+		"get_hweox__bulletins": {Service: "ProductAlertService", Method: "ListHWEOXBulletins"},
+		"get_psirt":            {Service: "ProductAlertService", Method: "ListSecurityAdvisories"},
+		"get_psirt__bulletins": {Service: "ProductAlertService", Method: "ListPSIRTBulletins"},
+		"get_sw_alerts":        {Service: "ProductAlertService", Method: "ListSoftwareAlerts"},
+		"get_sweox":            {Service: "ProductAlertService", Method: "ListSWEOX"},
+		"get_sweox__bulletins": {Service: "ProductAlertService", Method: "ListSWEOXBulletins"},
+		"get_crash_count":      {Service: "RiskMitigationService", Method: "CountCrashes"},
+		"get_crashes":          {Service: "RiskMitigationService", Method: "ListCrashes"},
+		"get_compliance":       {Service: "SoftwareTrackService", Method: "GetCompliance"},
+		"get_recomendations":   {Service: "SoftwareTrackService", Method: "GetRecommendations"},
+		"get_tracking_summary": {Service: "SoftwareTrackService", Method: "GetSummary"},
+	}
+
+	modelsTmpl   = template.Must(template.New("models").Parse(modelsSource))
+	servicesTmpl = template.Must(template.New("services").Parse(servicesSource))
+)
+
+// operation holds the names used to generate the service method for an operation.
+type operation struct {
+	Service string
+	Method  string
+	Body    string // The name to use for the request body type, which is defined inline.
+}
+
+type model struct {
+	Name    string
+	Schema  string
+	Comment string
+	Fields  []field
+}
+
+type field struct {
+	Name    string
+	Type    string
+	Tag     string
+	Comment string
+}
+
+type method struct {
+	Service    string
+	Name       string
+	Comment    string
+	HTTPMethod string
+	Path       string
+	Format     string
+	PathParams []string
+	Options    string
+	Body       string
+	Result     string // The result type, without any pointer.
+	Pointer    bool   // Whether the result is returned as a pointer.
+	Zero       string // The zero value of the result.
+}
+
+// generateModels returns the source for a model struct for each of the schemas in the specification.
+func generateModels(doc *openapi3.T) ([]byte, error) {
+	var models []model
+	for name, ref := range doc.Components.Schemas {
+		m := model{Name: typeName(name), Schema: name, Comment: comment(ref.Value.Description)}
+		fields, err := schemaFields(ref.Value)
+		if err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+		m.Fields = fields
+		models = append(models, m)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+	return execute(modelsTmpl, map[string]interface{}{"Models": models})
+}
+
+// generateServices returns the source for a service method for each of the operations in the
+// specification, along with any options and request body types they require.
+func generateServices(doc *openapi3.T) ([]byte, error) {
+	var methods []method
+	var types []model
+	imports := map[string]bool{"context": true, "fmt": true, "net/http": true, "net/url": true}
+	for path, item := range doc.Paths {
+		for httpMethod, op := range item.Operations() {
+			o, ok := operations[op.OperationID]
+			if !ok {
+				return nil, fmt.Errorf("no method name for operation %s %s (%s)", httpMethod, path, op.OperationID)
+			}
+			if o.Method == "" {
+				continue
+			}
+			m := method{
+				Service:    o.Service,
+				Name:       o.Method,
+				Comment:    comment(op.Description),
+				HTTPMethod: httpMethod,
+				Path:       path,
+				Format:     path,
+			}
+
+			params := append(append(openapi3.Parameters{}, item.Parameters...), op.Parameters...)
+			var query []*openapi3.Parameter
+			for _, p := range params {
+				switch p.Value.In {
+				case openapi3.ParameterInPath:
+					m.Format = strings.Replace(m.Format, "{"+p.Value.Name+"}", "%s", 1)
+				case openapi3.ParameterInQuery:
+					query = append(query, p.Value)
+				}
+			}
+			m.PathParams = pathParams(path, params)
+
+			options, extra, err := queryOptions(o, query)
+			if err != nil {
+				return nil, fmt.Errorf("operation %s: %w", op.OperationID, err)
+			}
+			m.Options = options
+			if extra != nil {
+				types = append(types, *extra)
+			}
+
+			if op.RequestBody != nil {
+				body, err := requestBody(o, op.RequestBody.Value)
+				if err != nil {
+					return nil, fmt.Errorf("operation %s: %w", op.OperationID, err)
+				}
+				m.Body = body.Name
+				types = append(types, *body)
+				imports["bytes"] = true
+				imports["encoding/json"] = true
+			}
+
+			if err := m.setResult(op.Responses); err != nil {
+				return nil, fmt.Errorf("operation %s: %w", op.OperationID, err)
+			}
+			if m.Result == "json.RawMessage" {
+				imports["encoding/json"] = true
+			}
+			methods = append(methods, m)
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		if methods[i].Service != methods[j].Service {
+			return methods[i].Service < methods[j].Service
+		}
+		return methods[i].Name < methods[j].Name
+	})
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	var importList []string
+	for imp := range imports {
+		importList = append(importList, imp)
+	}
+	sort.Strings(importList)
+	return execute(servicesTmpl, map[string]interface{}{
+		"Imports": importList,
+		"Types":   types,
+		"Methods": methods,
+	})
+}
+
+// pathParams returns the go names of the path parameters in the order they appear in the path.
+func pathParams(path string, params openapi3.Parameters) []string {
+	var names []string
+	for _, p := range params {
+		if p.Value.In == openapi3.ParameterInPath {
+			names = append(names, p.Value.Name)
+		}
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return strings.Index(path, "{"+names[i]+"}") < strings.Index(path, "{"+names[j]+"}")
+	})
+	for i, name := range names {
+		names[i] = paramName(name)
+	}
+	return names
+}
+
+// queryOptions returns the name of the options type for the query parameters of an operation.  The
+// common parameters use QueryOptions or ListOptions, while any others require an options type to be
+// generated, which is also returned.
+func queryOptions(o operation, params []*openapi3.Parameter) (string, *model, error) {
+	if len(params) == 0 {
+		return "", nil, nil
+	}
+	common := map[string]bool{}
+	var fields []field
+	for _, p := range params {
+		switch p.Name {
+		case "filter", "mask", "page", "perPage":
+			common[p.Name] = true
+			continue
+		}
+		typ, err := goType(p.Schema, p.Name)
+		if err != nil {
+			return "", nil, err
+		}
+		fields = append(fields, field{
+			Name:    fieldName(p.Name),
+			Type:    typ,
+			Tag:     fmt.Sprintf("`url:%q`", p.Name+",omitempty"),
+			Comment: comment(p.Description),
+		})
+	}
+	embedded := "QueryOptions"
+	if common["page"] || common["perPage"] {
+		embedded = "ListOptions"
+	}
+	if len(fields) == 0 {
+		return embedded, nil, nil
+	}
+	if len(common) > 0 {
+		fields = append([]field{{Type: embedded}}, fields...)
+	}
+	name := strings.TrimSuffix(o.Service, "Service") + o.Method + "Options"
+	return name, &model{
+		Name:    name,
+		Comment: fmt.Sprintf("specifies the optional parameters to the %s.%s method.", o.Service, o.Method),
+		Fields:  fields,
+	}, nil
+}
+
+// requestBody returns the request body type for an operation, which must be defined inline.
+func requestBody(o operation, body *openapi3.RequestBody) (*model, error) {
+	content := body.Content.Get("application/json")
+	if content == nil || content.Schema == nil {
+		return nil, fmt.Errorf("unsupported request body")
+	}
+	if content.Schema.Ref != "" {
+		return nil, fmt.Errorf("request body references %s, but only inline request bodies are supported", content.Schema.Ref)
+	}
+	if o.Body == "" {
+		return nil, fmt.Errorf("no name for request body")
+	}
+	fields, err := schemaFields(content.Schema.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &model{
+		Name:    o.Body,
+		Comment: fmt.Sprintf("defines the request body for the %s.%s method.", o.Service, o.Method),
+		Fields:  fields,
+	}, nil
+}
+
+// setResult sets the result type of the method from the successful response of the operation.
+func (m *method) setResult(responses openapi3.Responses) error {
+	var codes []int
+	for code := range responses {
+		if c, err := strconv.Atoi(code); err == nil && c >= 200 && c < 300 {
+			codes = append(codes, c)
+		}
+	}
+	if len(codes) == 0 {
+		return fmt.Errorf("no successful response")
+	}
+	sort.Ints(codes)
+	if codes[0] == http.StatusNoContent {
+		return nil
+	}
+	res := responses.Get(codes[0]).Value
+	var schema *openapi3.SchemaRef
+	if content := res.Content.Get("application/json"); content != nil {
+		schema = content.Schema
+	}
+	switch {
+	case schema == nil:
+		// the response is undocumented, so leave it to the caller to unmarshal
+		m.Result, m.Zero = "json.RawMessage", "nil"
+	case schema.Ref != "":
+		m.Result, m.Pointer, m.Zero = typeName(strings.TrimPrefix(schema.Ref, schemaRefPrefix)), true, "nil"
+	case schema.Value.Type == "integer":
+		m.Result, m.Zero = "int", "0"
+	default:
+		return fmt.Errorf("unsupported response schema type %q", schema.Value.Type)
+	}
+	return nil
+}
+
+// schemaFields returns the fields for the properties of the schema, which may be composed of other schemas.
+func schemaFields(s *openapi3.Schema) ([]field, error) {
+	var fields []field
+	for _, ref := range s.AllOf {
+		if ref.Ref != "" {
+			fields = append(fields, field{Type: typeName(strings.TrimPrefix(ref.Ref, schemaRefPrefix))})
+			continue
+		}
+		f, err := schemaFields(ref.Value)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f...)
+	}
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ref := s.Properties[name]
+		typ, err := goType(ref, name)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		if !strings.HasPrefix(typ, "[]") {
+			typ = "*" + typ
+		}
+		var description string
+		if ref.Value != nil {
+			description = ref.Value.Description
+		}
+		fields = append(fields, field{
+			Name:    fieldName(name),
+			Type:    typ,
+			Tag:     fmt.Sprintf("`json:%q`", name+",omitempty"),
+			Comment: comment(description),
+		})
+	}
+	return fields, nil
+}
+
+// goType returns the go type for a schema, e.g. string, DateTime or []Device.
+func goType(ref *openapi3.SchemaRef, name string) (string, error) {
+	if ref.Ref != "" {
+		return typeName(strings.TrimPrefix(ref.Ref, schemaRefPrefix)), nil
+	}
+	if typ, ok := fieldTypes[name]; ok {
+		return typ, nil
+	}
+	s := ref.Value
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date-time":
+			return "DateTime", nil
+		case "date":
+			return "Date", nil
+		}
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float32", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		typ, err := goType(s.Items, name)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + typ, nil
+	}
+	return "", fmt.Errorf("unsupported schema type %q", s.Type)
+}
+
+// typeName returns the model name for the named schema.
+func typeName(schema string) string {
+	if name, ok := typeNames[schema]; ok {
+		return name
+	}
+	return upperFirst(schema)
+}
+
+// fieldName returns the go name for a property or parameter, e.g. DaysBackward for days_backward.
+func fieldName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		b.WriteString(upperFirst(part))
+	}
+	return b.String()
+}
+
+// paramName returns the go name for a path parameter, e.g. feedbackID for feedback_id.
+func paramName(name string) string {
+	name = fieldName(name)
+	name = strings.ToLower(name[:1]) + name[1:]
+	if strings.HasSuffix(strings.ToLower(name), "id") {
+		name = name[:len(name)-2] + "ID"
+	}
+	return name
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// comment returns the description formatted as a go comment, without the leading slashes on
+// the first line.
+func comment(description string) string {
+	description = strings.ReplaceAll(description, "<br/>", "\n")
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+	return strings.Join(lines, "\n// ")
+}
+
+func execute(tmpl *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+const header = `// Code generated by convert-swagger-to-openapi; DO NOT EDIT.
+
+// Instead, please run "go generate ./..." from the root of the repository.
+
+package ciscobcs
+`
+
+const structSource = `{{define "struct"}}type {{.Name}} struct {
+{{- range $i, $f := .Fields}}
+{{- if and $i .Comment}}
+{{end}}
+{{- if .Comment}}
+	// {{.Comment}}{{end}}
+	{{if .Name}}{{.Name}} {{end}}{{.Type}} {{.Tag}}
+{{- end}}
+}
+{{end}}`
+
+const modelsSource = header + structSource + `
+{{range .Models}}
+// {{.Name}} defines model for {{.Schema}}.{{if .Comment}}
+// {{.Comment}}{{end}}
+{{template "struct" .}}
+{{end}}`
+
+const servicesSource = header + structSource + `
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Types}}
+// {{.Name}} {{.Comment}}
+{{template "struct" .}}
+{{end}}
+{{range .Methods}}
+// {{.Name}} calls {{.HTTPMethod}} {{.Path}}.{{if .Comment}}
+//
+// {{.Comment}}{{end}}
+func (s *{{.Service}}) {{.Name}}(ctx context.Context{{range .PathParams}}, {{.}} string{{end}}{{if .Body}}, body *{{.Body}}{{end}}{{if .Options}}, opts *{{.Options}}{{end}}) ({{if .Result}}{{if .Pointer}}*{{end}}{{.Result}}, {{end}}error) {
+	{{if .Options}}u, err := addOptions(fmt.Sprintf("%s{{.Format}}", s.client.BaseURL{{range .PathParams}}, url.PathEscape({{.}}){{end}}), opts)
+	if err != nil {
+		return {{if .Result}}{{.Zero}}, {{end}}err
+	}
+	{{else}}u := fmt.Sprintf("%s{{.Format}}", s.client.BaseURL{{range .PathParams}}, url.PathEscape({{.}}){{end}})
+	{{end -}}
+	{{if .Body}}buf, err := json.Marshal(body)
+	if err != nil {
+		return {{if .Result}}{{.Zero}}, {{end}}err
+	}
+	req, err := http.NewRequest("{{.HTTPMethod}}", u, bytes.NewReader(buf))
+	if err != nil {
+		return {{if .Result}}{{.Zero}}, {{end}}err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	{{else}}req, err := http.NewRequest("{{.HTTPMethod}}", u, nil)
+	if err != nil {
+		return {{if .Result}}{{.Zero}}, {{end}}err
+	}
+	{{end -}}
+	{{if .Result}}{{if .Pointer}}v := new({{.Result}})
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	{{else}}var v {{.Result}}
+	if err := s.client.makeRequest(ctx, req, &v); err != nil {
+		return {{.Zero}}, err
+	}
+	{{end}}return v, nil
+	{{- else}}return s.client.makeRequest(ctx, req, nil){{end}}
+}
+{{end}}`
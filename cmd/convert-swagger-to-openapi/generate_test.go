@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+// TestGeneratedCode fails when the converted specification or the code generated from it differs
+// from that checked in, i.e. when go generate has not been run following a change to the
+// specification or the generator.
+func TestGeneratedCode(t *testing.T) {
+	v3, spec, err := convert("swagger.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	models, err := generateModels(v3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	services, err := generateServices(v3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for filename, want := range map[string][]byte{
+		"openapi.json":                       spec,
+		"../../pkg/ciscobcs/models_gen.go":   models,
+		"../../pkg/ciscobcs/services_gen.go": services,
+	} {
+		got, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date with the specification; run go generate ./... from the root of the repository", filename)
+		}
+	}
+}
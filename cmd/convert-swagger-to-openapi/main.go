@@ -1,41 +1,92 @@
+// convert-swagger-to-openapi converts the Cisco BCS swagger (OpenAPI v2) specification to
+// OpenAPI v3 and optionally generates the ciscobcs models and service methods from it.
+//
+// It is meant to be used by go generate from the ciscobcs package directory, see ciscobcs.go.
+// Without any flags it will simply convert swagger.json to openapi.json in the current directory.
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"flag"
 	"io/ioutil"
 	"log"
 
 	"github.com/getkin/kin-openapi/openapi2"
 	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var (
+	swaggerFile  = flag.String("swagger", "swagger.json", "The swagger specification to convert")
+	openapiFile  = flag.String("openapi", "openapi.json", "Where to write the converted OpenAPI v3 specification")
+	modelsFile   = flag.String("models", "", "Where to write the generated models, if required")
+	servicesFile = flag.String("services", "", "Where to write the generated service methods, if required")
 )
 
-//go:generate oapi-codegen -package bcs-openapi -o ./bcs-openapi.gen.go ./openapi.json
 func main() {
-	// Load v2 openapi
-	input, err := ioutil.ReadFile("swagger.json")
+	flag.Parse()
+
+	v3, j, err := convert(*swaggerFile)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if err := ioutil.WriteFile(*openapiFile, j, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	if *modelsFile != "" {
+		src, err := generateModels(v3)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(*modelsFile, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *servicesFile != "" {
+		src, err := generateServices(v3)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(*servicesFile, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// convert loads the v2 swagger specification from filename and returns it converted to v3, along with
+// its indented json representation.
+func convert(filename string) (*openapi3.T, []byte, error) {
+	// Load v2 openapi
+	input, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
 	var doc openapi2.T
 	if err = json.Unmarshal(input, &doc); err != nil {
-		log.Fatal(err)
+		return nil, nil, err
 	}
 
 	// Convert to v3 openapi
 	v3, err := openapi2conv.ToV3(&doc)
 	if err != nil {
-		log.Fatal(err)
+		return nil, nil, err
 	}
 
-	// Validate and write
+	// Validate and marshal
 	err = v3.Validate(context.Background())
 	if err != nil {
-		log.Fatal(err)
+		return nil, nil, err
 	}
 	j, err := v3.MarshalJSON()
 	if err != nil {
-		log.Fatal(err)
+		return nil, nil, err
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, j, "", "    "); err != nil {
+		return nil, nil, err
 	}
-	ioutil.WriteFile("openapi.json", j, 0644)
+	return v3, buf.Bytes(), nil
 }
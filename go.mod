@@ -4,6 +4,8 @@ go 1.16
 
 require (
 	github.com/getkin/kin-openapi v0.78.0
+	github.com/ghodss/yaml v1.0.0
+	github.com/klauspost/compress v1.13.6
 	github.com/mattn/go-colorable v0.1.8 // indirect
 	github.com/mitchellh/cli v1.1.2
 	golang.org/x/crypto v0.0.0-20201221181555-eec23a3978ad // indirect
@@ -0,0 +1,181 @@
+package ciscobcs
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Names of the issues reported by an audit of the devices.
+const (
+	AuditUnreachable           = "unreachable"
+	AuditInventoryNotCompleted = "inventory-not-completed"
+	AuditConfigNotCompleted    = "config-not-completed"
+	AuditStaleInventory        = "stale-inventory"
+	AuditStaleConfig           = "stale-config"
+)
+
+// AuditIssues lists the names of the issues reported by an audit, in the order they are reported.
+var AuditIssues = []string{
+	AuditUnreachable,
+	AuditInventoryNotCompleted,
+	AuditConfigNotCompleted,
+	AuditStaleInventory,
+	AuditStaleConfig,
+}
+
+// DeviceAuditFinding holds the issues found with the collection of a single device.
+type DeviceAuditFinding struct {
+	Device Device   `json:"device"`
+	Issues []string `json:"issues"`
+
+	// InventoryAge and ConfigAge are the time since the inventory and config were last collected,
+	// or nil where they have never been collected.  They are marshalled to JSON in whole seconds,
+	// as inventoryAgeSeconds and configAgeSeconds.
+	InventoryAge *time.Duration `json:"-"`
+	ConfigAge    *time.Duration `json:"-"`
+}
+
+// MarshalJSON marshals the finding with the ages in whole seconds.
+func (f DeviceAuditFinding) MarshalJSON() ([]byte, error) {
+	type finding DeviceAuditFinding
+	return json.Marshal(struct {
+		finding
+		InventoryAge *int64 `json:"inventoryAgeSeconds,omitempty"`
+		ConfigAge    *int64 `json:"configAgeSeconds,omitempty"`
+	}{finding(f), seconds(f.InventoryAge), seconds(f.ConfigAge)})
+}
+
+// Has reports whether the finding includes the named issue.
+func (f *DeviceAuditFinding) Has(issue string) bool {
+	for _, i := range f.Issues {
+		if i == issue {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectorAudit holds the findings for the devices of a single collector.
+type CollectorAudit struct {
+	Collector string `json:"collector"`
+
+	// Devices is the number of devices audited for the collector, with or without issues.
+	Devices  int                  `json:"devices"`
+	Findings []DeviceAuditFinding `json:"findings,omitempty"`
+
+	// Counts holds the number of devices with each issue, keyed by the name of the issue.
+	Counts map[string]int `json:"counts"`
+}
+
+// DeviceAudit is the result of auditing the reachability and freshness of the data for a set of
+// devices, grouped by collector.
+type DeviceAudit struct {
+	Ref time.Time `json:"ref"`
+
+	// MaxAge is the age after which data is stale, marshalled to JSON in whole seconds as
+	// maxAgeSeconds.
+	MaxAge     time.Duration    `json:"-"`
+	Devices    int              `json:"devices"`
+	Collectors []CollectorAudit `json:"collectors"`
+
+	// Counts holds the number of devices with each issue across all collectors.
+	Counts map[string]int `json:"counts"`
+}
+
+// MarshalJSON marshals the audit with MaxAge in whole seconds.
+func (a DeviceAudit) MarshalJSON() ([]byte, error) {
+	type audit DeviceAudit
+	return json.Marshal(struct {
+		audit
+		MaxAge int64 `json:"maxAgeSeconds"`
+	}{audit(a), int64(a.MaxAge / time.Second)})
+}
+
+// AuditDevices reports the devices that are unreachable, whose InventoryStatus or ConfigStatus is
+// not Completed, or whose inventory or config was last collected more than maxAge before ref,
+// including those never collected.  Devices are grouped by collector, sorted by name, with the
+// findings for each in device order.  Staleness is not checked where maxAge is zero or less.
+func AuditDevices(devices []Device, ref time.Time, maxAge time.Duration) *DeviceAudit {
+	a := &DeviceAudit{Ref: ref, MaxAge: maxAge, Devices: len(devices), Counts: make(map[string]int)}
+	collectors := make(map[string]int)
+	for i := range devices {
+		d := &devices[i]
+		name := d.GetCollector()
+		c, ok := collectors[name]
+		if !ok {
+			c = len(a.Collectors)
+			collectors[name] = c
+			a.Collectors = append(a.Collectors, CollectorAudit{Collector: name, Counts: make(map[string]int)})
+		}
+		ca := &a.Collectors[c]
+		ca.Devices++
+		f, ok := auditDevice(d, ref, maxAge)
+		if !ok {
+			continue
+		}
+		ca.Findings = append(ca.Findings, f)
+		for _, issue := range f.Issues {
+			ca.Counts[issue]++
+			a.Counts[issue]++
+		}
+	}
+	sort.Slice(a.Collectors, func(i, j int) bool { return a.Collectors[i].Collector < a.Collectors[j].Collector })
+	return a
+}
+
+// AuditDevices audits the devices in the results.  See AuditDevices.
+func (r *BulkResults) AuditDevices(ref time.Time, maxAge time.Duration) *DeviceAudit {
+	return AuditDevices(r.Devices, ref, maxAge)
+}
+
+// auditDevice returns the finding for the device, reporting false where there are no issues.
+func auditDevice(d *Device, ref time.Time, maxAge time.Duration) (DeviceAuditFinding, bool) {
+	f := DeviceAuditFinding{Device: *d}
+	if unreachable(d) {
+		f.Issues = append(f.Issues, AuditUnreachable)
+	}
+	if !strings.EqualFold(d.GetInventoryStatus(), "Completed") {
+		f.Issues = append(f.Issues, AuditInventoryNotCompleted)
+	}
+	if !strings.EqualFold(d.GetConfigStatus(), "Completed") {
+		f.Issues = append(f.Issues, AuditConfigNotCompleted)
+	}
+	f.InventoryAge = age(d.InventoryTime, ref)
+	f.ConfigAge = age(d.ConfigTime, ref)
+	if maxAge > 0 {
+		if f.InventoryAge == nil || *f.InventoryAge > maxAge {
+			f.Issues = append(f.Issues, AuditStaleInventory)
+		}
+		if f.ConfigAge == nil || *f.ConfigAge > maxAge {
+			f.Issues = append(f.Issues, AuditStaleConfig)
+		}
+	}
+	return f, len(f.Issues) > 0
+}
+
+// age returns the time from t to ref, or nil where t is nil or zero.
+func age(t *DateTime, ref time.Time) *time.Duration {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	d := ref.Sub(t.Time)
+	return &d
+}
+
+// seconds returns the duration in whole seconds, or nil where it is nil.
+func seconds(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	s := int64(*d / time.Second)
+	return &s
+}
+
+// unreachable reports whether the DeviceStatus shows that the device cannot be reached by the
+// collector, e.g. DEVICE NOT REACHABLE.
+func unreachable(d *Device) bool {
+	status := strings.ToUpper(d.GetDeviceStatus())
+	return strings.Contains(status, "NOT REACHABLE") || strings.Contains(status, "UNREACHABLE")
+}
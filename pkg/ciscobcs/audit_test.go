@@ -0,0 +1,86 @@
+package ciscobcs
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAuditDevices(t *testing.T) {
+	ref := time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)
+	recent := &DateTime{ref.AddDate(0, 0, -1)}
+	old := &DateTime{ref.AddDate(0, -2, 0)}
+	devices := []Device{
+		{DeviceId: Int(1), Collector: String("lok1"), DeviceStatus: String("ACTIVE"), InventoryStatus: String("Completed"), ConfigStatus: String("Completed"), InventoryTime: recent, ConfigTime: recent},
+		{DeviceId: Int(2), Collector: String("lok1"), DeviceStatus: String("DEVICE NOT REACHABLE"), InventoryStatus: String("NotAvailable"), ConfigStatus: String("NotAvailable"), InventoryTime: old},
+		{DeviceId: Int(3), Collector: String("bci2"), DeviceStatus: String("ACTIVE"), InventoryStatus: String("Completed"), ConfigStatus: String("NotSupported"), InventoryTime: recent, ConfigTime: old},
+	}
+	a := AuditDevices(devices, ref, 30*24*time.Hour)
+	if len(a.Collectors) != 2 || a.Collectors[0].Collector != "bci2" || a.Collectors[1].Collector != "lok1" {
+		t.Fatalf("got collectors %+v; want bci2 and lok1", a.Collectors)
+	}
+	lok1 := a.Collectors[1]
+	if lok1.Devices != 2 || len(lok1.Findings) != 1 || lok1.Findings[0].Device.GetDeviceId() != 2 {
+		t.Fatalf("got %+v; want one finding for device 2", lok1)
+	}
+	f := lok1.Findings[0]
+	if want := []string{AuditUnreachable, AuditInventoryNotCompleted, AuditConfigNotCompleted, AuditStaleInventory, AuditStaleConfig}; !reflect.DeepEqual(f.Issues, want) {
+		t.Errorf("got %v; want %v", f.Issues, want)
+	}
+	if f.ConfigAge != nil || f.InventoryAge == nil || *f.InventoryAge != ref.Sub(old.Time) {
+		t.Errorf("got ages %v, %v; want never collected config", f.InventoryAge, f.ConfigAge)
+	}
+	if want := []string{AuditConfigNotCompleted, AuditStaleConfig}; !reflect.DeepEqual(a.Collectors[0].Findings[0].Issues, want) {
+		t.Errorf("got %v; want %v", a.Collectors[0].Findings[0].Issues, want)
+	}
+	if a.Counts[AuditStaleConfig] != 2 || a.Counts[AuditUnreachable] != 1 || a.Devices != 3 {
+		t.Errorf("got counts %v for %v devices", a.Counts, a.Devices)
+	}
+	if a := AuditDevices(devices, ref, 0); a.Counts[AuditStaleInventory] != 0 || a.Counts[AuditStaleConfig] != 0 {
+		t.Errorf("got %v; want staleness not checked", a.Counts)
+	}
+	b, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		MaxAge     *int64 `json:"maxAgeSeconds"`
+		Devices    int    `json:"devices"`
+		Collectors []struct {
+			Findings []map[string]interface{} `json:"findings"`
+		} `json:"collectors"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.MaxAge == nil || *got.MaxAge != 30*24*60*60 || got.Devices != 3 {
+		t.Errorf("got max age %v for %v devices; want 30 days in seconds for 3", got.MaxAge, got.Devices)
+	}
+	finding := got.Collectors[1].Findings[0]
+	if age, _ := finding["inventoryAgeSeconds"].(float64); age != ref.Sub(old.Time).Seconds() || finding["configAgeSeconds"] != nil || finding["device"] == nil {
+		t.Errorf("got finding %v; want inventory age in seconds", finding)
+	}
+
+	t.Run("demo", func(t *testing.T) {
+		results := demoResults(t)
+		a := results.AuditDevices(ref, 90*24*time.Hour)
+		total, unreachable := 0, 0
+		for _, c := range a.Collectors {
+			total += c.Devices
+			for _, f := range c.Findings {
+				if f.Device.GetCollector() != c.Collector {
+					t.Errorf("got device %v for collector %v", f.Device.GetDeviceId(), c.Collector)
+				}
+			}
+		}
+		for _, d := range results.Devices {
+			if d.GetDeviceStatus() == "DEVICE NOT REACHABLE" {
+				unreachable++
+			}
+		}
+		if total != len(results.Devices) || a.Counts[AuditUnreachable] != unreachable || unreachable == 0 {
+			t.Errorf("got %v devices, %v unreachable; want %v, %v", total, a.Counts[AuditUnreachable], len(results.Devices), unreachable)
+		}
+	})
+}
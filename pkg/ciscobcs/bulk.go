@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"time"
 )
 
 // BulkResults holds various details from the bulk download, including
@@ -18,7 +19,8 @@ import (
 // to do anything with them. Typically this is going to be errors relating
 // to unmarshalling etc., e.g. where non-standard dates types are used.
 // Finally, a list of unrecognised types is returned in case there are
-// additional types that we haven't received before.
+// additional types that we haven't received before.  Tags holds any
+// tags derived for the devices, once ApplyTagRules has been called.
 type BulkResults struct {
 	LineCount                  int
 	CountOfTypes               map[string]int
@@ -30,9 +32,49 @@ type BulkResults struct {
 	HWEoxBulletins             []HWEOXBulletin
 	FNBulletins                []FNBulletin
 	PSIRTBulletins             []PSIRTBulletin
+	SecurityAdvisories         []SecurityAdvisory
+	FieldNotices               []FieldNotice
+	Tags                       DeviceTags
 	Errors                     []error
 }
 
+// BulkRecord is a single line of bulk data once its type has been identified and it
+// has been unmarshalled.  Value holds one of the model types, e.g. Device or PSIRTBulletin,
+// or nil where the type is not recognised.  Err holds any non-critical error unmarshalling
+// the line, in which case Value may only be partially populated.  Advisories holds the PSIRT
+// bulletins matched to a device, which are nested in its psirt field in the bulk data, and
+// FieldNotices the field notices matched to its hardware, which are nested in its assets.
+type BulkRecord struct {
+	Line         int
+	Type         string
+	Value        interface{}
+	Advisories   []SecurityAdvisory
+	FieldNotices []FieldNotice
+	Err          error
+}
+
+// DownloadOptions specifies the optional parameters to the BulkService.DownloadWithOptions method.
+type DownloadOptions struct {
+	// Compression is the format used to compress the data written.  Defaults to CompressionNone.
+	Compression Compression
+
+	// Retries is the number of times an interrupted download will be resumed before giving up.
+	// Defaults to 3.  Use a negative value to disable resuming.
+	Retries int
+
+	// RetryDelay is the time to wait before the first retry, which is doubled for each
+	// subsequent retry up to a maximum of 30 seconds.  Defaults to one second.
+	RetryDelay time.Duration
+
+	// Progress, when provided, is called periodically while the download is in progress and
+	// once more when the transfer completes.  It is called from the downloading goroutine, so
+	// should return quickly.
+	Progress func(DownloadProgress)
+
+	// ProgressInterval is the minimum time between calls to Progress.  Defaults to one second.
+	ProgressInterval time.Duration
+}
+
 // Retrieve will make a bulk request and return a BulkResults item.
 func (s *BulkService) Retrieve(ctx context.Context, customerID string) (*BulkResults, error) {
 	url := fmt.Sprintf("%s/customer/%s/bulk/alerts", s.client.BaseURL, customerID)
@@ -40,6 +82,7 @@ func (s *BulkService) Retrieve(ctx context.Context, customerID string) (*BulkRes
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Accept-Encoding", acceptEncoding)
 	return s.client.makeBulkRequest(ctx, req)
 }
 
@@ -47,23 +90,53 @@ func (s *BulkService) Retrieve(ctx context.Context, customerID string) (*BulkRes
 // Typically this is going to be a file to write to.  The data is not parsed,
 // it is written directly to the writer.
 func (s *BulkService) Download(ctx context.Context, customerID string, w io.Writer) error {
+	_, err := s.DownloadWithOptions(ctx, customerID, w, nil)
+	return err
+}
+
+// DownloadWithOptions will make a bulk request and write it to the provided io.Writer
+// in the same way as Download, but allows the output to be compressed.  The data is
+// requested from the bulk endpoint using a compressed transfer encoding where available
+// and interrupted transfers are resumed using Range requests.  A summary is returned
+// once the download has passed its sanity checks.
+func (s *BulkService) DownloadWithOptions(ctx context.Context, customerID string, w io.Writer, opts *DownloadOptions) (*DownloadSummary, error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+	retries := opts.Retries
+	if retries == 0 {
+		retries = defaultDownloadRetries
+	}
 	url := fmt.Sprintf("%s/customer/%s/bulk/alerts", s.client.BaseURL, customerID)
-	req, err := http.NewRequest("GET", url, nil)
+	cw, err := NewCompressingWriter(w, opts.Compression)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	summary, err := s.client.download(ctx, url, cw, retries, opts.RetryDelay, newProgressReporter(opts.Progress, opts.ProgressInterval))
+	if err != nil {
+		return nil, err
 	}
-	return s.client.makeRequestToWriter(ctx, req, w)
+	if err := cw.Close(); err != nil {
+		return nil, err
+	}
+	return summary, nil
 }
 
 // ParseBulkFile will take a raw jsonlines file and return the results as a BulkResults struct
 // Note that it is not part of a service since no details are required just to parse a file.
+// Files compressed with gzip or zstd are detected and decompressed automatically.
 func ParseBulkFile(filename string) (*BulkResults, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
-	return scanBulk(file)
+	r, err := NewDecompressingReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return scanBulk(r)
 }
 
 // makeBulkRequest provides a function specifically for the bulk data which is sent as jsonlines format
@@ -75,125 +148,189 @@ func (c *Client) makeBulkRequest(ctx context.Context, req *http.Request) (*BulkR
 		return nil, err
 	}
 	defer res.Body.Close()
-	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		var ciscobcsErr error
-		switch res.StatusCode {
-		case 400:
-			ciscobcsErr = ErrBadRequest
-		case 401:
-			ciscobcsErr = ErrUnauthorized
-		case 403:
-			ciscobcsErr = ErrForbidden
-		case 500:
-			ciscobcsErr = ErrInternalError
-		default:
-			ciscobcsErr = ErrUnknown
-		}
-		return nil, ciscobcsErr
+	if err := checkResponse(res); err != nil {
+		return nil, err
+	}
+	body, err := decodedBody(res)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	results, err := scanBulk(body)
+	if err != nil {
+		return nil, err
+	}
+	if c.NormalizeResults {
+		results.Normalize()
 	}
-	return scanBulk(res.Body)
+	return results, nil
 }
 
 // scanBulk will scan each line of a jsonlines body, either from a file
 // or from a direct request and will identify the different types, before
 // unmarshalling them into their respective structs.
 func scanBulk(body io.Reader) (*BulkResults, error) {
-	results := &BulkResults{}
+	results := newBulkResults()
+	scanner := newBulkScanner(body)
+	for scanner.Scan() {
+		rec, err := decodeBulkLine(scanner.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		rec.Line = results.LineCount + 1
+		results.add(rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// newBulkResults returns an empty BulkResults with all of its maps and slices initialised.
+func newBulkResults() *BulkResults {
+	return &BulkResults{
+		CountOfTypes:               make(map[string]int),
+		UnrecognisedTypes:          make(map[string]int),
+		Devices:                    []Device{},
+		TrackSummaries:             []TrackSummary{},
+		TrackSmupieRecommendations: []TrackSmupieRecommendation{},
+		SWEoxBulletins:             []SWEOXBulletin{},
+		HWEoxBulletins:             []HWEOXBulletin{},
+		FNBulletins:                []FNBulletin{},
+		PSIRTBulletins:             []PSIRTBulletin{},
+		SecurityAdvisories:         []SecurityAdvisory{},
+		FieldNotices:               []FieldNotice{},
+	}
+}
+
+// newBulkScanner returns a line scanner for jsonlines bulk data.
+func newBulkScanner(body io.Reader) *bufio.Scanner {
 	scanner := bufio.NewScanner(body)
 	// scanner has a limit of 65k, so lets set a larger buffer for it to use
 	const maxCapacity = 65536 * 2
 	buf := make([]byte, maxCapacity)
 	scanner.Buffer(buf, maxCapacity)
+	return scanner
+}
 
-	// Set up our results
-	lineCount := 0
-	countOfTypes := make(map[string]int)
-	unrecognisedTypes := make(map[string]int)
-	results.Devices = []Device{}
-	results.TrackSummaries = []TrackSummary{}
-	results.TrackSmupieRecommendations = []TrackSmupieRecommendation{}
-	results.SWEoxBulletins = []SWEOXBulletin{}
-	results.HWEoxBulletins = []HWEOXBulletin{}
-	results.FNBulletins = []FNBulletin{}
-	results.PSIRTBulletins = []PSIRTBulletin{}
+// bulkDevice is a device record as it appears in the bulk data, which includes the PSIRT
+// bulletins matched to the device and the field notices matched to each of its assets.
+type bulkDevice struct {
+	Device
+	Psirt  []SecurityAdvisory `json:"psirt,omitempty"`
+	Assets []bulkAsset        `json:"assets,omitempty"`
+}
 
-	for scanner.Scan() {
-		line := scanner.Bytes()
-		lineCount++
-		// first we need to check the line type before we can unmarshal it
-		var lineType BulkTypeChecker
-		err := json.Unmarshal(line, &lineType)
-		if err != nil {
-			return nil, errors.New("error unmarshalling type: check input file")
+// bulkAsset is the part of an asset of a device in the bulk data which is retained, i.e. the
+// field notices matched to it.
+type bulkAsset struct {
+	PhysicalElementId *int          `json:"physicalElementId,omitempty"`
+	FieldNotices      []FieldNotice `json:"fieldNotices,omitempty"`
+}
+
+// newBulkDevice returns the bulk data record for the device with the given advisories and
+// field notices, which are nested under an asset for each PhysicalElementId.
+func newBulkDevice(d Device, advisories []SecurityAdvisory, notices []FieldNotice) bulkDevice {
+	v := bulkDevice{Device: d, Psirt: advisories}
+	assets := make(map[int]int)
+	for _, n := range notices {
+		id := n.GetPhysicalElementId()
+		i, ok := assets[id]
+		if !ok {
+			i = len(v.Assets)
+			assets[id] = i
+			v.Assets = append(v.Assets, bulkAsset{PhysicalElementId: n.PhysicalElementId})
 		}
-		// Process each type from here
-		switch lineType.Type {
-		case "device":
-			countOfTypes[lineType.Type]++
-			var v Device
-			err := json.Unmarshal(line, &v)
-			if err != nil {
-				results.Errors = append(results.Errors, fmt.Errorf("device: %w", err))
-			}
-			results.Devices = append(results.Devices, v)
-		case "track_summary":
-			countOfTypes[lineType.Type]++
-			var v TrackSummary
-			err := json.Unmarshal(line, &v)
-			if err != nil {
-				results.Errors = append(results.Errors, fmt.Errorf("track_summary: %w", err))
-			}
-			results.TrackSummaries = append(results.TrackSummaries, v)
-		case "track_smupie_recommendation":
-			countOfTypes[lineType.Type]++
-			var v TrackSmupieRecommendation
-			err := json.Unmarshal(line, &v)
-			if err != nil {
-				results.Errors = append(results.Errors, fmt.Errorf("track_smupie_recommendation: %w", err))
-			}
-			results.TrackSmupieRecommendations = append(results.TrackSmupieRecommendations, v)
-		case "sw_eox_bulletin":
-			countOfTypes[lineType.Type]++
-			var v SWEOXBulletin
-			err := json.Unmarshal(line, &v)
-			if err != nil {
-				results.Errors = append(results.Errors, fmt.Errorf("sw_eox_bulletin: %w", err))
-			}
-			results.SWEoxBulletins = append(results.SWEoxBulletins, v)
-		case "hw_eox_bulletin":
-			countOfTypes[lineType.Type]++
-			var v HWEOXBulletin
-			err := json.Unmarshal(line, &v)
-			if err != nil {
-				results.Errors = append(results.Errors, fmt.Errorf("hw_eox_bulletin: %w", err))
-			}
-			results.HWEoxBulletins = append(results.HWEoxBulletins, v)
-		case "fn_bulletin":
-			countOfTypes[lineType.Type]++
-			var v FNBulletin
-			err := json.Unmarshal(line, &v)
-			if err != nil {
-				results.Errors = append(results.Errors, fmt.Errorf("fn_bulletin: %w", err))
-			}
-			results.FNBulletins = append(results.FNBulletins, v)
-		case "psirt_bulletin":
-			countOfTypes[lineType.Type]++
-			var v PSIRTBulletin
-			err := json.Unmarshal(line, &v)
-			if err != nil {
-				results.Errors = append(results.Errors, fmt.Errorf("psirt_bulletin: %w", err))
-			}
-			results.PSIRTBulletins = append(results.PSIRTBulletins, v)
-		default:
-			unrecognisedTypes[lineType.Type]++
+		v.Assets[i].FieldNotices = append(v.Assets[i].FieldNotices, n)
+	}
+	return v
+}
+
+// decodeBulkLine identifies the type of a single jsonlines object and unmarshals it
+// into its respective struct.  An error is only returned when the type itself cannot
+// be determined; errors unmarshalling the record are held in the record's Err field.
+func decodeBulkLine(line []byte) (BulkRecord, error) {
+	// first we need to check the line type before we can unmarshal it
+	var lineType BulkTypeChecker
+	err := json.Unmarshal(line, &lineType)
+	if err != nil {
+		return BulkRecord{}, errors.New("error unmarshalling type: check input file")
+	}
+	rec := BulkRecord{Type: lineType.Type}
+	// Process each type from here
+	switch lineType.Type {
+	case "device":
+		var v bulkDevice
+		rec.Err = json.Unmarshal(line, &v)
+		rec.Value, rec.Advisories = v.Device, v.Psirt
+		for _, a := range v.Assets {
+			rec.FieldNotices = append(rec.FieldNotices, a.FieldNotices...)
 		}
+	case "track_summary":
+		var v TrackSummary
+		rec.Err = json.Unmarshal(line, &v)
+		rec.Value = v
+	case "track_smupie_recommendation":
+		var v TrackSmupieRecommendation
+		rec.Err = json.Unmarshal(line, &v)
+		rec.Value = v
+	case "sw_eox_bulletin":
+		var v SWEOXBulletin
+		rec.Err = json.Unmarshal(line, &v)
+		rec.Value = v
+	case "hw_eox_bulletin":
+		var v HWEOXBulletin
+		rec.Err = json.Unmarshal(line, &v)
+		rec.Value = v
+	case "fn_bulletin":
+		var v FNBulletin
+		rec.Err = json.Unmarshal(line, &v)
+		rec.Value = v
+	case "psirt_bulletin":
+		var v PSIRTBulletin
+		rec.Err = json.Unmarshal(line, &v)
+		rec.Value = v
+	}
+	if rec.Err != nil {
+		rec.Err = fmt.Errorf("%s: %w", rec.Type, rec.Err)
+	}
+	return rec, nil
+}
 
+// add records the given record against the results, updating the counts and
+// appending the value to the slice for its type.
+func (r *BulkResults) add(rec BulkRecord) {
+	r.count(rec)
+	switch v := rec.Value.(type) {
+	case Device:
+		r.Devices = append(r.Devices, v)
+		r.SecurityAdvisories = append(r.SecurityAdvisories, rec.Advisories...)
+		r.FieldNotices = append(r.FieldNotices, rec.FieldNotices...)
+	case TrackSummary:
+		r.TrackSummaries = append(r.TrackSummaries, v)
+	case TrackSmupieRecommendation:
+		r.TrackSmupieRecommendations = append(r.TrackSmupieRecommendations, v)
+	case SWEOXBulletin:
+		r.SWEoxBulletins = append(r.SWEoxBulletins, v)
+	case HWEOXBulletin:
+		r.HWEoxBulletins = append(r.HWEoxBulletins, v)
+	case FNBulletin:
+		r.FNBulletins = append(r.FNBulletins, v)
+	case PSIRTBulletin:
+		r.PSIRTBulletins = append(r.PSIRTBulletins, v)
 	}
-	if err := scanner.Err(); err != nil {
-		return nil, err
+}
+
+// count records the given record against the results counts and errors only,
+// without retaining the value itself.
+func (r *BulkResults) count(rec BulkRecord) {
+	r.LineCount++
+	if rec.Value == nil {
+		r.UnrecognisedTypes[rec.Type]++
+		return
+	}
+	r.CountOfTypes[rec.Type]++
+	if rec.Err != nil {
+		r.Errors = append(r.Errors, rec.Err)
 	}
-	results.LineCount = lineCount
-	results.CountOfTypes = countOfTypes
-	results.UnrecognisedTypes = unrecognisedTypes
-	return results, nil
 }
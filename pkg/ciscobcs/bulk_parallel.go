@@ -0,0 +1,166 @@
+package ciscobcs
+
+import (
+	"context"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+)
+
+// BulkOptions specifies the optional parameters for parsing bulk data with ParseBulk.
+type BulkOptions struct {
+	// Workers is the number of goroutines used to decode lines.  Lines are always read
+	// sequentially, only the unmarshalling is spread across workers.  Defaults to runtime.NumCPU().
+	Workers int
+
+	// Unordered delivers records as soon as they are decoded rather than in their original line order.
+	Unordered bool
+
+	// Handler, when provided, is called with each record in turn instead of the value being
+	// appended to the BulkResults slices, which keeps memory use bounded for large files.
+	// Counts and errors are still collected.  It is never called concurrently and returning
+	// an error will stop parsing.
+	Handler func(BulkRecord) error
+}
+
+// bulkJob holds a single line as it passes through the decoding pipeline.
+type bulkJob struct {
+	line int
+	data []byte
+	rec  BulkRecord
+	err  error
+	done chan struct{}
+}
+
+// ParseBulkFileWithOptions will take a raw jsonlines file and parse it using ParseBulk with the given options.
+// As with ParseBulkFile, compressed files are detected and decompressed automatically.
+func ParseBulkFileWithOptions(ctx context.Context, filename string, opts *BulkOptions) (*BulkResults, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	r, err := NewDecompressingReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ParseBulk(ctx, r, opts)
+}
+
+// ParseBulk will read jsonlines bulk data from the provided reader and decode it across a
+// pool of workers.  Records are delivered to the results, or opts.Handler if provided, in
+// their original line order unless opts.Unordered is set.  Only a small window of lines,
+// proportional to the number of workers, is held in memory at any one time.  Cancelling
+// the context stops parsing and returns the context error.
+func ParseBulk(ctx context.Context, body io.Reader, opts *BulkOptions) (*BulkResults, error) {
+	if opts == nil {
+		opts = &BulkOptions{}
+	}
+	workers := opts.Workers
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// window is the maximum number of lines queued for decoding or delivery
+	window := workers * 4
+	jobs := make(chan *bulkJob, window)
+	pending := make(chan *bulkJob, window)
+	decoded := make(chan *bulkJob, window)
+
+	var readErr error
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+		scanner := newBulkScanner(body)
+		line := 0
+		for scanner.Scan() {
+			line++
+			j := &bulkJob{
+				line: line,
+				data: append([]byte(nil), scanner.Bytes()...),
+				done: make(chan struct{}),
+			}
+			if !opts.Unordered {
+				select {
+				case pending <- j:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case jobs <- j:
+			case <-ctx.Done():
+				return
+			}
+		}
+		readErr = scanner.Err()
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				if ctx.Err() == nil {
+					j.rec, j.err = decodeBulkLine(j.data)
+					j.rec.Line = j.line
+				}
+				j.data = nil
+				close(j.done)
+				if opts.Unordered {
+					select {
+					case decoded <- j:
+					case <-ctx.Done():
+					}
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(decoded)
+	}()
+
+	results := newBulkResults()
+	deliver := func(j *bulkJob) error {
+		if j.err != nil {
+			return j.err
+		}
+		if opts.Handler != nil {
+			results.count(j.rec)
+			return opts.Handler(j.rec)
+		}
+		results.add(j.rec)
+		return nil
+	}
+
+	queue := pending
+	if opts.Unordered {
+		queue = decoded
+	}
+	for j := range queue {
+		select {
+		case <-j.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := deliver(j); err != nil {
+			return nil, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+	return results, nil
+}
@@ -1,7 +1,13 @@
 package ciscobcs
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -46,6 +52,173 @@ func TestBulk(t *testing.T) {
 				t.Errorf("missing key %v in countOfTypes", wantkey)
 			}
 		}
+		if len(got.SecurityAdvisories) != 1841 {
+			t.Errorf("got %v device security advisories; want %v", len(got.SecurityAdvisories), 1841)
+		}
+		if len(got.FieldNotices) != 212 {
+			t.Errorf("got %v device field notices; want %v", len(got.FieldNotices), 212)
+		}
+	})
+	t.Run("bulk file compressed", func(t *testing.T) {
+		for _, c := range []Compression{CompressionNone, CompressionGzip, CompressionZstd} {
+			filename := filepath.Join(t.TempDir(), "bcs_bulk.jsonl"+c.Extension())
+			f, err := os.Create(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w, err := NewCompressingWriter(f, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w.Write(file)
+			w.Close()
+			f.Close()
+			got, err := ParseBulkFile(filename)
+			if err != nil {
+				t.Fatalf("%q: didn't expect error parsing file: %v", c, err)
+			}
+			if got.LineCount != 996 {
+				t.Errorf("%q: got %v; want %v", c, got.LineCount, 996)
+			}
+		}
+	})
+	t.Run("bulk writer round trip", func(t *testing.T) {
+		want, err := scanBulk(strings.NewReader(string(file)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		bw := NewBulkWriter(&buf)
+		if err := bw.WriteResults(want); err != nil {
+			t.Fatalf("didn't expect error writing results: %v", err)
+		}
+		if err := bw.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		got, err := scanBulk(&buf)
+		if err != nil {
+			t.Fatalf("didn't expect error scanning written results: %v", err)
+		}
+		if got.LineCount != want.LineCount {
+			t.Errorf("got %v; want %v", got.LineCount, want.LineCount)
+		}
+		if !reflect.DeepEqual(got.CountOfTypes, want.CountOfTypes) {
+			t.Errorf("got %v; want %v", got.CountOfTypes, want.CountOfTypes)
+		}
+		if len(got.Errors) != 0 {
+			t.Errorf("got %v errors scanning written results; want none", len(got.Errors))
+		}
+		got.Errors, want.Errors = nil, nil
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("written results do not round trip")
+		}
+	})
+	t.Run("device security advisories", func(t *testing.T) {
+		line := []byte(`{"deviceId":1,"deviceName":"router1","psirt":[{"deviceId":1,"matchConfidence":"Vulnerable","psirtColdId":100},{"deviceId":1,"psirtColdId":200}],"assets":[{"physicalElementId":5,"fieldNotices":[{"deviceId":1,"fieldNoticeId":"63743","physicalElementId":5}]}],"type":"device"}`)
+		rec, err := decodeBulkLine(line)
+		if err != nil || rec.Err != nil {
+			t.Fatalf("didn't expect error decoding device: %v, %v", err, rec.Err)
+		}
+		d, ok := rec.Value.(Device)
+		if !ok || d.GetDeviceName() != "router1" {
+			t.Fatalf("got %#v; want Device", rec.Value)
+		}
+		if len(rec.Advisories) != 2 || rec.Advisories[1].GetPsirtColdId() != 200 || rec.Advisories[0].GetMatchConfidence() != "Vulnerable" {
+			t.Errorf("got advisories %+v; want 2", rec.Advisories)
+		}
+		if len(rec.FieldNotices) != 1 || rec.FieldNotices[0].GetFieldNoticeId() != "63743" || rec.FieldNotices[0].GetPhysicalElementId() != 5 {
+			t.Errorf("got field notices %+v; want 1", rec.FieldNotices)
+		}
+		r := newBulkResults()
+		r.add(rec)
+		if len(r.Devices) != 1 || !reflect.DeepEqual(r.SecurityAdvisories, rec.Advisories) || !reflect.DeepEqual(r.FieldNotices, rec.FieldNotices) {
+			t.Errorf("got %v devices, advisories %+v, field notices %+v; want the record's", len(r.Devices), r.SecurityAdvisories, r.FieldNotices)
+		}
+
+		var buf bytes.Buffer
+		bw := NewBulkWriter(&buf)
+		if err := bw.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+		if err := bw.Write(d); err != nil {
+			t.Fatal(err)
+		}
+		if err := bw.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if len(lines) != 2 || lines[0] != string(line) {
+			t.Errorf("got %q; want %s", lines, line)
+		}
+		if again, _ := decodeBulkLine([]byte(lines[1])); len(again.Advisories) != 0 || len(again.FieldNotices) != 0 {
+			t.Errorf("got %v advisories and %v field notices writing a device; want none", len(again.Advisories), len(again.FieldNotices))
+		}
+	})
+	t.Run("parallel parse ordered", func(t *testing.T) {
+		want, err := scanBulk(strings.NewReader(string(file)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ParseBulk(context.Background(), strings.NewReader(string(file)), &BulkOptions{Workers: 4})
+		if err != nil {
+			t.Fatalf("didn't expect error parsing: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("parallel results differ from sequential results")
+		}
+	})
+	t.Run("parallel parse unordered handler", func(t *testing.T) {
+		countOfTypes := make(map[string]int)
+		opts := &BulkOptions{
+			Workers:   4,
+			Unordered: true,
+			Handler: func(rec BulkRecord) error {
+				countOfTypes[rec.Type]++
+				return nil
+			},
+		}
+		got, err := ParseBulk(context.Background(), strings.NewReader(string(file)), opts)
+		if err != nil {
+			t.Fatalf("didn't expect error parsing: %v", err)
+		}
+		if got.LineCount != 996 {
+			t.Errorf("got %v; want %v", got.LineCount, 996)
+		}
+		if len(got.Devices) != 0 {
+			t.Errorf("got %v devices; want none retained when using a handler", len(got.Devices))
+		}
+		if !reflect.DeepEqual(countOfTypes, got.CountOfTypes) {
+			t.Errorf("got %v; want %v", countOfTypes, got.CountOfTypes)
+		}
+	})
+	t.Run("parallel parse handler error", func(t *testing.T) {
+		errStop := errors.New("stop")
+		seen := 0
+		opts := &BulkOptions{
+			Workers: 4,
+			Handler: func(rec BulkRecord) error {
+				seen++
+				if rec.Line != seen {
+					t.Errorf("got line %v; want %v", rec.Line, seen)
+				}
+				if seen == 10 {
+					return errStop
+				}
+				return nil
+			},
+		}
+		_, err := ParseBulk(context.Background(), strings.NewReader(string(file)), opts)
+		if !errors.Is(err, errStop) {
+			t.Errorf("got %v; want %v", err, errStop)
+		}
+	})
+	t.Run("parallel parse cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := ParseBulk(ctx, strings.NewReader(string(file)), nil)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got %v; want %v", err, context.Canceled)
+		}
 	})
 }
 
@@ -59,3 +232,66 @@ func BenchmarkBulk(b *testing.B) {
 		scanBulk(filereader)
 	}
 }
+
+func BenchmarkBulkParallel(b *testing.B) {
+	file, err := os.ReadFile("../../demo_bcs_bulk.jsonl")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for n := 0; n < b.N; n++ {
+		filereader := strings.NewReader(string(file))
+		ParseBulk(context.Background(), filereader, nil)
+	}
+}
+
+// demoResults returns the parsed results of the demo bulk file.
+func demoResults(t testing.TB) *BulkResults {
+	t.Helper()
+	results, err := ParseBulkFile("../../demo_bcs_bulk.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return results
+}
+
+// demoDeviceExtras returns the fields of each device in the demo bulk file which are not part
+// of the Device model, such as swEox and softwareTracks, keyed by DeviceId.  They record Cisco's
+// own analysis, so are used to check that of the package.
+func demoDeviceExtras(t testing.TB) map[int]demoDeviceExtra {
+	t.Helper()
+	f, err := os.Open("../../demo_bcs_bulk.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	extras := make(map[int]demoDeviceExtra)
+	scanner := newBulkScanner(f)
+	for scanner.Scan() {
+		var record struct {
+			Type     string `json:"type"`
+			DeviceId int    `json:"deviceId"`
+			demoDeviceExtra
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatal(err)
+		}
+		if record.Type == "device" {
+			extras[record.DeviceId] = record.demoDeviceExtra
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return extras
+}
+
+type demoDeviceExtra struct {
+	SwEox *struct {
+		SwEoxId int `json:"swEoxId"`
+	} `json:"swEox"`
+	SoftwareTracks []struct {
+		TrackId                int  `json:"trackId"`
+		TrackCompliant         bool `json:"trackCompliant"`
+		TrackPreviousCompliant bool `json:"trackPreviousCompliant"`
+	} `json:"softwareTracks"`
+}
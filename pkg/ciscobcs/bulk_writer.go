@@ -0,0 +1,139 @@
+package ciscobcs
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// BulkWriter writes records as jsonlines in the same format as the bulk endpoint, so that
+// the output can be read back with ParseBulkFile.  It is typically used to write out a
+// filtered set of records from a BulkResults.  Output is buffered, so Flush must be called
+// once all records have been written.
+type BulkWriter struct {
+	w *bufio.Writer
+}
+
+// NewBulkWriter returns a new BulkWriter that writes to w.
+func NewBulkWriter(w io.Writer) *BulkWriter {
+	return &BulkWriter{w: bufio.NewWriter(w)}
+}
+
+// Write writes a single record followed by a newline.  The record can be any of the bulk
+// model types, e.g. Device or PSIRTBulletin, either as a value or a pointer, or a BulkRecord.
+// The type discriminator for the record is added to the output.
+func (bw *BulkWriter) Write(v interface{}) error {
+	if rec, ok := v.(BulkRecord); ok {
+		v = rec.Value
+		if d, ok := v.(Device); ok && (len(rec.Advisories) > 0 || len(rec.FieldNotices) > 0) {
+			v = newBulkDevice(d, rec.Advisories, rec.FieldNotices)
+		}
+	}
+	bulkType, ok := bulkTypeOf(v)
+	if !ok {
+		return ErrUnrecognisedBulkType
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if b[0] != '{' {
+		return ErrUnrecognisedBulkType
+	}
+	// add the type as the final field of the object, as it is in the bulk data
+	b = b[:len(b)-1]
+	if len(b) > 1 {
+		b = append(b, ',')
+	}
+	b = append(b, `"type":`...)
+	t, _ := json.Marshal(bulkType)
+	b = append(b, t...)
+	b = append(b, '}', '\n')
+	_, err = bw.w.Write(b)
+	return err
+}
+
+// WriteResults writes all of the records held in r, grouped by type.
+func (bw *BulkWriter) WriteResults(r *BulkResults) error {
+	for _, v := range r.SWEoxBulletins {
+		if err := bw.Write(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.HWEoxBulletins {
+		if err := bw.Write(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.FNBulletins {
+		if err := bw.Write(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PSIRTBulletins {
+		if err := bw.Write(v); err != nil {
+			return err
+		}
+	}
+	advisories, notices := r.deviceAlerts()
+	for _, v := range r.Devices {
+		// the advisories and field notices are nested in the first record for the device, as
+		// they are in the bulk data
+		id := v.GetDeviceId()
+		if err := bw.Write(newBulkDevice(v, advisories[id], notices[id])); err != nil {
+			return err
+		}
+		delete(advisories, id)
+		delete(notices, id)
+	}
+	for _, v := range r.TrackSummaries {
+		if err := bw.Write(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.TrackSmupieRecommendations {
+		if err := bw.Write(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deviceAlerts returns the advisories and field notices in the results keyed by DeviceId.
+func (r *BulkResults) deviceAlerts() (map[int][]SecurityAdvisory, map[int][]FieldNotice) {
+	advisories := make(map[int][]SecurityAdvisory)
+	for _, a := range r.SecurityAdvisories {
+		advisories[a.GetDeviceId()] = append(advisories[a.GetDeviceId()], a)
+	}
+	notices := make(map[int][]FieldNotice)
+	for _, n := range r.FieldNotices {
+		notices[n.GetDeviceId()] = append(notices[n.GetDeviceId()], n)
+	}
+	return advisories, notices
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+func (bw *BulkWriter) Flush() error {
+	return bw.w.Flush()
+}
+
+// bulkTypeOf returns the bulk type discriminator for the given model value.
+func bulkTypeOf(v interface{}) (string, bool) {
+	switch v.(type) {
+	case Device, *Device, bulkDevice:
+		return "device", true
+	case TrackSummary, *TrackSummary:
+		return "track_summary", true
+	case TrackSmupieRecommendation, *TrackSmupieRecommendation:
+		return "track_smupie_recommendation", true
+	case SWEOXBulletin, *SWEOXBulletin:
+		return "sw_eox_bulletin", true
+	case HWEOXBulletin, *HWEOXBulletin:
+		return "hw_eox_bulletin", true
+	case FNBulletin, *FNBulletin:
+		return "fn_bulletin", true
+	case PSIRTBulletin, *PSIRTBulletin:
+		return "psirt_bulletin", true
+	}
+	return "", false
+}
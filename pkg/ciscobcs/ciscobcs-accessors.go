@@ -0,0 +1,2165 @@
+// Code generated by gen-accessors; DO NOT EDIT.
+
+// Instead, please run "go generate ./..." from the root of the repository.
+
+package ciscobcs
+
+// GetChassisName returns the ChassisName field if it's non-nil, zero value otherwise.
+func (a *Asset) GetChassisName() string {
+	if a == nil || a.ChassisName == nil {
+		return ""
+	}
+	return *a.ChassisName
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (a *Asset) GetDeviceId() int {
+	if a == nil || a.DeviceId == nil {
+		return 0
+	}
+	return *a.DeviceId
+}
+
+// GetDeviceName returns the DeviceName field if it's non-nil, zero value otherwise.
+func (a *Asset) GetDeviceName() string {
+	if a == nil || a.DeviceName == nil {
+		return ""
+	}
+	return *a.DeviceName
+}
+
+// GetHwRev returns the HwRev field if it's non-nil, zero value otherwise.
+func (a *Asset) GetHwRev() string {
+	if a == nil || a.HwRev == nil {
+		return ""
+	}
+	return *a.HwRev
+}
+
+// GetInstalledFlash returns the InstalledFlash field if it's non-nil, zero value otherwise.
+func (a *Asset) GetInstalledFlash() int {
+	if a == nil || a.InstalledFlash == nil {
+		return 0
+	}
+	return *a.InstalledFlash
+}
+
+// GetInstalledMemory returns the InstalledMemory field if it's non-nil, zero value otherwise.
+func (a *Asset) GetInstalledMemory() int {
+	if a == nil || a.InstalledMemory == nil {
+		return 0
+	}
+	return *a.InstalledMemory
+}
+
+// GetPcb returns the Pcb field if it's non-nil, zero value otherwise.
+func (a *Asset) GetPcb() string {
+	if a == nil || a.Pcb == nil {
+		return ""
+	}
+	return *a.Pcb
+}
+
+// GetPcbRev returns the PcbRev field if it's non-nil, zero value otherwise.
+func (a *Asset) GetPcbRev() string {
+	if a == nil || a.PcbRev == nil {
+		return ""
+	}
+	return *a.PcbRev
+}
+
+// GetPhysicalElementId returns the PhysicalElementId field if it's non-nil, zero value otherwise.
+func (a *Asset) GetPhysicalElementId() int {
+	if a == nil || a.PhysicalElementId == nil {
+		return 0
+	}
+	return *a.PhysicalElementId
+}
+
+// GetPhysicalSubtype returns the PhysicalSubtype field if it's non-nil, zero value otherwise.
+func (a *Asset) GetPhysicalSubtype() string {
+	if a == nil || a.PhysicalSubtype == nil {
+		return ""
+	}
+	return *a.PhysicalSubtype
+}
+
+// GetPhysicalType returns the PhysicalType field if it's non-nil, zero value otherwise.
+func (a *Asset) GetPhysicalType() string {
+	if a == nil || a.PhysicalType == nil {
+		return ""
+	}
+	return *a.PhysicalType
+}
+
+// GetProductFamily returns the ProductFamily field if it's non-nil, zero value otherwise.
+func (a *Asset) GetProductFamily() string {
+	if a == nil || a.ProductFamily == nil {
+		return ""
+	}
+	return *a.ProductFamily
+}
+
+// GetProductId returns the ProductId field if it's non-nil, zero value otherwise.
+func (a *Asset) GetProductId() string {
+	if a == nil || a.ProductId == nil {
+		return ""
+	}
+	return *a.ProductId
+}
+
+// GetProductType returns the ProductType field if it's non-nil, zero value otherwise.
+func (a *Asset) GetProductType() string {
+	if a == nil || a.ProductType == nil {
+		return ""
+	}
+	return *a.ProductType
+}
+
+// GetSerialNumber returns the SerialNumber field if it's non-nil, zero value otherwise.
+func (a *Asset) GetSerialNumber() string {
+	if a == nil || a.SerialNumber == nil {
+		return ""
+	}
+	return *a.SerialNumber
+}
+
+// GetSerialNumberStatus returns the SerialNumberStatus field if it's non-nil, zero value otherwise.
+func (a *Asset) GetSerialNumberStatus() string {
+	if a == nil || a.SerialNumberStatus == nil {
+		return ""
+	}
+	return *a.SerialNumberStatus
+}
+
+// GetSlot returns the Slot field if it's non-nil, zero value otherwise.
+func (a *Asset) GetSlot() string {
+	if a == nil || a.Slot == nil {
+		return ""
+	}
+	return *a.Slot
+}
+
+// GetSwVersion returns the SwVersion field if it's non-nil, zero value otherwise.
+func (a *Asset) GetSwVersion() string {
+	if a == nil || a.SwVersion == nil {
+		return ""
+	}
+	return *a.SwVersion
+}
+
+// GetTan returns the Tan field if it's non-nil, zero value otherwise.
+func (a *Asset) GetTan() string {
+	if a == nil || a.Tan == nil {
+		return ""
+	}
+	return *a.Tan
+}
+
+// GetTanRev returns the TanRev field if it's non-nil, zero value otherwise.
+func (a *Asset) GetTanRev() string {
+	if a == nil || a.TanRev == nil {
+		return ""
+	}
+	return *a.TanRev
+}
+
+// GetBpNuggetId returns the BpNuggetId field if it's non-nil, zero value otherwise.
+func (c *CBPDetails) GetBpNuggetId() int {
+	if c == nil || c.BpNuggetId == nil {
+		return 0
+	}
+	return *c.BpNuggetId
+}
+
+// GetBpRuleId returns the BpRuleId field if it's non-nil, zero value otherwise.
+func (c *CBPDetails) GetBpRuleId() int {
+	if c == nil || c.BpRuleId == nil {
+		return 0
+	}
+	return *c.BpRuleId
+}
+
+// GetConfigSource returns the ConfigSource field if it's non-nil, zero value otherwise.
+func (c *CBPDetails) GetConfigSource() string {
+	if c == nil || c.ConfigSource == nil {
+		return ""
+	}
+	return *c.ConfigSource
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (c *CBPDetails) GetDeviceId() int {
+	if c == nil || c.DeviceId == nil {
+		return 0
+	}
+	return *c.DeviceId
+}
+
+// GetBpCaveat returns the BpCaveat field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpCaveat() string {
+	if c == nil || c.BpCaveat == nil {
+		return ""
+	}
+	return *c.BpCaveat
+}
+
+// GetBpCorrectiveAction returns the BpCorrectiveAction field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpCorrectiveAction() string {
+	if c == nil || c.BpCorrectiveAction == nil {
+		return ""
+	}
+	return *c.BpCorrectiveAction
+}
+
+// GetBpDescription returns the BpDescription field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpDescription() string {
+	if c == nil || c.BpDescription == nil {
+		return ""
+	}
+	return *c.BpDescription
+}
+
+// GetBpNuggetId returns the BpNuggetId field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpNuggetId() int {
+	if c == nil || c.BpNuggetId == nil {
+		return 0
+	}
+	return *c.BpNuggetId
+}
+
+// GetBpPrimaryTechnology returns the BpPrimaryTechnology field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpPrimaryTechnology() string {
+	if c == nil || c.BpPrimaryTechnology == nil {
+		return ""
+	}
+	return *c.BpPrimaryTechnology
+}
+
+// GetBpRecommendation returns the BpRecommendation field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpRecommendation() string {
+	if c == nil || c.BpRecommendation == nil {
+		return ""
+	}
+	return *c.BpRecommendation
+}
+
+// GetBpRisk returns the BpRisk field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpRisk() string {
+	if c == nil || c.BpRisk == nil {
+		return ""
+	}
+	return *c.BpRisk
+}
+
+// GetBpRuleId returns the BpRuleId field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpRuleId() int {
+	if c == nil || c.BpRuleId == nil {
+		return 0
+	}
+	return *c.BpRuleId
+}
+
+// GetBpSecondaryTechnology returns the BpSecondaryTechnology field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpSecondaryTechnology() string {
+	if c == nil || c.BpSecondaryTechnology == nil {
+		return ""
+	}
+	return *c.BpSecondaryTechnology
+}
+
+// GetBpTitle returns the BpTitle field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetBpTitle() string {
+	if c == nil || c.BpTitle == nil {
+		return ""
+	}
+	return *c.BpTitle
+}
+
+// GetCreateDate returns the CreateDate field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetCreateDate() DateTime {
+	if c == nil || c.CreateDate == nil {
+		return DateTime{}
+	}
+	return *c.CreateDate
+}
+
+// GetSwType returns the SwType field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetSwType() string {
+	if c == nil || c.SwType == nil {
+		return ""
+	}
+	return *c.SwType
+}
+
+// GetUpdateDate returns the UpdateDate field if it's non-nil, zero value otherwise.
+func (c *CBPRule) GetUpdateDate() DateTime {
+	if c == nil || c.UpdateDate == nil {
+		return DateTime{}
+	}
+	return *c.UpdateDate
+}
+
+// GetBpRuleId returns the BpRuleId field if it's non-nil, zero value otherwise.
+func (c *CBPRuleReference) GetBpRuleId() int {
+	if c == nil || c.BpRuleId == nil {
+		return 0
+	}
+	return *c.BpRuleId
+}
+
+// GetBpUrl returns the BpUrl field if it's non-nil, zero value otherwise.
+func (c *CBPRuleReference) GetBpUrl() string {
+	if c == nil || c.BpUrl == nil {
+		return ""
+	}
+	return *c.BpUrl
+}
+
+// GetBpUrlTitle returns the BpUrlTitle field if it's non-nil, zero value otherwise.
+func (c *CBPRuleReference) GetBpUrlTitle() string {
+	if c == nil || c.BpUrlTitle == nil {
+		return ""
+	}
+	return *c.BpUrlTitle
+}
+
+// GetBpNuggetId returns the BpNuggetId field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetBpNuggetId() int {
+	if c == nil || c.BpNuggetId == nil {
+		return 0
+	}
+	return *c.BpNuggetId
+}
+
+// GetBpPrimaryTechnology returns the BpPrimaryTechnology field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetBpPrimaryTechnology() string {
+	if c == nil || c.BpPrimaryTechnology == nil {
+		return ""
+	}
+	return *c.BpPrimaryTechnology
+}
+
+// GetBpRisk returns the BpRisk field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetBpRisk() string {
+	if c == nil || c.BpRisk == nil {
+		return ""
+	}
+	return *c.BpRisk
+}
+
+// GetBpRuleId returns the BpRuleId field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetBpRuleId() int {
+	if c == nil || c.BpRuleId == nil {
+		return 0
+	}
+	return *c.BpRuleId
+}
+
+// GetBpSecondaryTechnology returns the BpSecondaryTechnology field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetBpSecondaryTechnology() string {
+	if c == nil || c.BpSecondaryTechnology == nil {
+		return ""
+	}
+	return *c.BpSecondaryTechnology
+}
+
+// GetBpTitle returns the BpTitle field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetBpTitle() string {
+	if c == nil || c.BpTitle == nil {
+		return ""
+	}
+	return *c.BpTitle
+}
+
+// GetSwType returns the SwType field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetSwType() string {
+	if c == nil || c.SwType == nil {
+		return ""
+	}
+	return *c.SwType
+}
+
+// GetTotalDevices returns the TotalDevices field if it's non-nil, zero value otherwise.
+func (c *CBPSummary) GetTotalDevices() int {
+	if c == nil || c.TotalDevices == nil {
+		return 0
+	}
+	return *c.TotalDevices
+}
+
+// GetApplianceId returns the ApplianceId field if it's non-nil, zero value otherwise.
+func (c *Collector) GetApplianceId() string {
+	if c == nil || c.ApplianceId == nil {
+		return ""
+	}
+	return *c.ApplianceId
+}
+
+// GetCollector returns the Collector field if it's non-nil, zero value otherwise.
+func (c *Collector) GetCollector() string {
+	if c == nil || c.Collector == nil {
+		return ""
+	}
+	return *c.Collector
+}
+
+// GetCollectorStatus returns the CollectorStatus field if it's non-nil, zero value otherwise.
+func (c *Collector) GetCollectorStatus() string {
+	if c == nil || c.CollectorStatus == nil {
+		return ""
+	}
+	return *c.CollectorStatus
+}
+
+// GetCollectorVersion returns the CollectorVersion field if it's non-nil, zero value otherwise.
+func (c *Collector) GetCollectorVersion() string {
+	if c == nil || c.CollectorVersion == nil {
+		return ""
+	}
+	return *c.CollectorVersion
+}
+
+// GetExpectedUploadInterval returns the ExpectedUploadInterval field if it's non-nil, zero value otherwise.
+func (c *Collector) GetExpectedUploadInterval() int {
+	if c == nil || c.ExpectedUploadInterval == nil {
+		return 0
+	}
+	return *c.ExpectedUploadInterval
+}
+
+// GetLastUploadComplete returns the LastUploadComplete field if it's non-nil, zero value otherwise.
+func (c *Collector) GetLastUploadComplete() DateTime {
+	if c == nil || c.LastUploadComplete == nil {
+		return DateTime{}
+	}
+	return *c.LastUploadComplete
+}
+
+// GetBasePid returns the BasePid field if it's non-nil, zero value otherwise.
+func (c *ContractBasePID) GetBasePid() string {
+	if c == nil || c.BasePid == nil {
+		return ""
+	}
+	return *c.BasePid
+}
+
+// GetCiscoMlEtrees returns the CiscoMlEtrees field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetCiscoMlEtrees() float32 {
+	if c == nil || c.CiscoMlEtrees == nil {
+		return 0
+	}
+	return *c.CiscoMlEtrees
+}
+
+// GetCiscoMlKmeans returns the CiscoMlKmeans field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetCiscoMlKmeans() float32 {
+	if c == nil || c.CiscoMlKmeans == nil {
+		return 0
+	}
+	return *c.CiscoMlKmeans
+}
+
+// GetCiscoMlLatent returns the CiscoMlLatent field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetCiscoMlLatent() float32 {
+	if c == nil || c.CiscoMlLatent == nil {
+		return 0
+	}
+	return *c.CiscoMlLatent
+}
+
+// GetCiscoMlNeighbors returns the CiscoMlNeighbors field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetCiscoMlNeighbors() float32 {
+	if c == nil || c.CiscoMlNeighbors == nil {
+		return 0
+	}
+	return *c.CiscoMlNeighbors
+}
+
+// GetCiscoMlNeuralnets returns the CiscoMlNeuralnets field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetCiscoMlNeuralnets() float32 {
+	if c == nil || c.CiscoMlNeuralnets == nil {
+		return 0
+	}
+	return *c.CiscoMlNeuralnets
+}
+
+// GetCiscoMlTopics returns the CiscoMlTopics field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetCiscoMlTopics() float32 {
+	if c == nil || c.CiscoMlTopics == nil {
+		return 0
+	}
+	return *c.CiscoMlTopics
+}
+
+// GetCiscoMlTotal returns the CiscoMlTotal field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetCiscoMlTotal() float32 {
+	if c == nil || c.CiscoMlTotal == nil {
+		return 0
+	}
+	return *c.CiscoMlTotal
+}
+
+// GetDeviceHigh returns the DeviceHigh field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetDeviceHigh() float32 {
+	if c == nil || c.DeviceHigh == nil {
+		return 0
+	}
+	return *c.DeviceHigh
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetDeviceId() int {
+	if c == nil || c.DeviceId == nil {
+		return 0
+	}
+	return *c.DeviceId
+}
+
+// GetDeviceLow returns the DeviceLow field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetDeviceLow() float32 {
+	if c == nil || c.DeviceLow == nil {
+		return 0
+	}
+	return *c.DeviceLow
+}
+
+// GetDeviceName returns the DeviceName field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetDeviceName() string {
+	if c == nil || c.DeviceName == nil {
+		return ""
+	}
+	return *c.DeviceName
+}
+
+// GetDeviceRisk returns the DeviceRisk field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetDeviceRisk() float32 {
+	if c == nil || c.DeviceRisk == nil {
+		return 0
+	}
+	return *c.DeviceRisk
+}
+
+// GetGlobalRiskRank returns the GlobalRiskRank field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetGlobalRiskRank() string {
+	if c == nil || c.GlobalRiskRank == nil {
+		return ""
+	}
+	return *c.GlobalRiskRank
+}
+
+// GetProductFamily returns the ProductFamily field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetProductFamily() string {
+	if c == nil || c.ProductFamily == nil {
+		return ""
+	}
+	return *c.ProductFamily
+}
+
+// GetProductId returns the ProductId field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetProductId() string {
+	if c == nil || c.ProductId == nil {
+		return ""
+	}
+	return *c.ProductId
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (c *CrashRisk) GetVersion() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetCollector returns the Collector field if it's non-nil, zero value otherwise.
+func (d *Device) GetCollector() string {
+	if d == nil || d.Collector == nil {
+		return ""
+	}
+	return *d.Collector
+}
+
+// GetConfigRegister returns the ConfigRegister field if it's non-nil, zero value otherwise.
+func (d *Device) GetConfigRegister() string {
+	if d == nil || d.ConfigRegister == nil {
+		return ""
+	}
+	return *d.ConfigRegister
+}
+
+// GetConfigStatus returns the ConfigStatus field if it's non-nil, zero value otherwise.
+func (d *Device) GetConfigStatus() string {
+	if d == nil || d.ConfigStatus == nil {
+		return ""
+	}
+	return *d.ConfigStatus
+}
+
+// GetConfigTime returns the ConfigTime field if it's non-nil, zero value otherwise.
+func (d *Device) GetConfigTime() DateTime {
+	if d == nil || d.ConfigTime == nil {
+		return DateTime{}
+	}
+	return *d.ConfigTime
+}
+
+// GetCreateDate returns the CreateDate field if it's non-nil, zero value otherwise.
+func (d *Device) GetCreateDate() DateTime {
+	if d == nil || d.CreateDate == nil {
+		return DateTime{}
+	}
+	return *d.CreateDate
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (d *Device) GetDeviceId() int {
+	if d == nil || d.DeviceId == nil {
+		return 0
+	}
+	return *d.DeviceId
+}
+
+// GetDeviceIp returns the DeviceIp field if it's non-nil, zero value otherwise.
+func (d *Device) GetDeviceIp() string {
+	if d == nil || d.DeviceIp == nil {
+		return ""
+	}
+	return *d.DeviceIp
+}
+
+// GetDeviceName returns the DeviceName field if it's non-nil, zero value otherwise.
+func (d *Device) GetDeviceName() string {
+	if d == nil || d.DeviceName == nil {
+		return ""
+	}
+	return *d.DeviceName
+}
+
+// GetDeviceStatus returns the DeviceStatus field if it's non-nil, zero value otherwise.
+func (d *Device) GetDeviceStatus() string {
+	if d == nil || d.DeviceStatus == nil {
+		return ""
+	}
+	return *d.DeviceStatus
+}
+
+// GetDeviceSysName returns the DeviceSysName field if it's non-nil, zero value otherwise.
+func (d *Device) GetDeviceSysName() string {
+	if d == nil || d.DeviceSysName == nil {
+		return ""
+	}
+	return *d.DeviceSysName
+}
+
+// GetDeviceType returns the DeviceType field if it's non-nil, zero value otherwise.
+func (d *Device) GetDeviceType() string {
+	if d == nil || d.DeviceType == nil {
+		return ""
+	}
+	return *d.DeviceType
+}
+
+// GetFeatureSetdesc returns the FeatureSetdesc field if it's non-nil, zero value otherwise.
+func (d *Device) GetFeatureSetdesc() string {
+	if d == nil || d.FeatureSetdesc == nil {
+		return ""
+	}
+	return *d.FeatureSetdesc
+}
+
+// GetImageName returns the ImageName field if it's non-nil, zero value otherwise.
+func (d *Device) GetImageName() string {
+	if d == nil || d.ImageName == nil {
+		return ""
+	}
+	return *d.ImageName
+}
+
+// GetInSeedFile returns the InSeedFile field if it's non-nil, zero value otherwise.
+func (d *Device) GetInSeedFile() bool {
+	if d == nil || d.InSeedFile == nil {
+		return false
+	}
+	return *d.InSeedFile
+}
+
+// GetInventoryStatus returns the InventoryStatus field if it's non-nil, zero value otherwise.
+func (d *Device) GetInventoryStatus() string {
+	if d == nil || d.InventoryStatus == nil {
+		return ""
+	}
+	return *d.InventoryStatus
+}
+
+// GetInventoryTime returns the InventoryTime field if it's non-nil, zero value otherwise.
+func (d *Device) GetInventoryTime() DateTime {
+	if d == nil || d.InventoryTime == nil {
+		return DateTime{}
+	}
+	return *d.InventoryTime
+}
+
+// GetIpAddress returns the IpAddress field if it's non-nil, zero value otherwise.
+func (d *Device) GetIpAddress() string {
+	if d == nil || d.IpAddress == nil {
+		return ""
+	}
+	return *d.IpAddress
+}
+
+// GetLastReset returns the LastReset field if it's non-nil, zero value otherwise.
+func (d *Device) GetLastReset() DateTime {
+	if d == nil || d.LastReset == nil {
+		return DateTime{}
+	}
+	return *d.LastReset
+}
+
+// GetProductFamily returns the ProductFamily field if it's non-nil, zero value otherwise.
+func (d *Device) GetProductFamily() string {
+	if d == nil || d.ProductFamily == nil {
+		return ""
+	}
+	return *d.ProductFamily
+}
+
+// GetProductId returns the ProductId field if it's non-nil, zero value otherwise.
+func (d *Device) GetProductId() string {
+	if d == nil || d.ProductId == nil {
+		return ""
+	}
+	return *d.ProductId
+}
+
+// GetProductType returns the ProductType field if it's non-nil, zero value otherwise.
+func (d *Device) GetProductType() string {
+	if d == nil || d.ProductType == nil {
+		return ""
+	}
+	return *d.ProductType
+}
+
+// GetResetReason returns the ResetReason field if it's non-nil, zero value otherwise.
+func (d *Device) GetResetReason() string {
+	if d == nil || d.ResetReason == nil {
+		return ""
+	}
+	return *d.ResetReason
+}
+
+// GetSwType returns the SwType field if it's non-nil, zero value otherwise.
+func (d *Device) GetSwType() string {
+	if d == nil || d.SwType == nil {
+		return ""
+	}
+	return *d.SwType
+}
+
+// GetSwVersion returns the SwVersion field if it's non-nil, zero value otherwise.
+func (d *Device) GetSwVersion() string {
+	if d == nil || d.SwVersion == nil {
+		return ""
+	}
+	return *d.SwVersion
+}
+
+// GetSysContact returns the SysContact field if it's non-nil, zero value otherwise.
+func (d *Device) GetSysContact() string {
+	if d == nil || d.SysContact == nil {
+		return ""
+	}
+	return *d.SysContact
+}
+
+// GetSysDescription returns the SysDescription field if it's non-nil, zero value otherwise.
+func (d *Device) GetSysDescription() string {
+	if d == nil || d.SysDescription == nil {
+		return ""
+	}
+	return *d.SysDescription
+}
+
+// GetSysLocation returns the SysLocation field if it's non-nil, zero value otherwise.
+func (d *Device) GetSysLocation() string {
+	if d == nil || d.SysLocation == nil {
+		return ""
+	}
+	return *d.SysLocation
+}
+
+// GetSysObjectId returns the SysObjectId field if it's non-nil, zero value otherwise.
+func (d *Device) GetSysObjectId() string {
+	if d == nil || d.SysObjectId == nil {
+		return ""
+	}
+	return *d.SysObjectId
+}
+
+// GetUserField1 returns the UserField1 field if it's non-nil, zero value otherwise.
+func (d *Device) GetUserField1() string {
+	if d == nil || d.UserField1 == nil {
+		return ""
+	}
+	return *d.UserField1
+}
+
+// GetUserField2 returns the UserField2 field if it's non-nil, zero value otherwise.
+func (d *Device) GetUserField2() string {
+	if d == nil || d.UserField2 == nil {
+		return ""
+	}
+	return *d.UserField2
+}
+
+// GetUserField3 returns the UserField3 field if it's non-nil, zero value otherwise.
+func (d *Device) GetUserField3() string {
+	if d == nil || d.UserField3 == nil {
+		return ""
+	}
+	return *d.UserField3
+}
+
+// GetUserField4 returns the UserField4 field if it's non-nil, zero value otherwise.
+func (d *Device) GetUserField4() string {
+	if d == nil || d.UserField4 == nil {
+		return ""
+	}
+	return *d.UserField4
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (e *ErrorResponse) GetMessage() string {
+	if e == nil || e.Message == nil {
+		return ""
+	}
+	return *e.Message
+}
+
+// GetTrackingId returns the TrackingId field if it's non-nil, zero value otherwise.
+func (e *ErrorResponse) GetTrackingId() string {
+	if e == nil || e.TrackingId == nil {
+		return ""
+	}
+	return *e.TrackingId
+}
+
+// GetApp returns the App field if it's non-nil, zero value otherwise.
+func (f *Feedback) GetApp() string {
+	if f == nil || f.App == nil {
+		return ""
+	}
+	return *f.App
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (f *Feedback) GetEmail() string {
+	if f == nil || f.Email == nil {
+		return ""
+	}
+	return *f.Email
+}
+
+// GetFeedback returns the Feedback field if it's non-nil, zero value otherwise.
+func (f *Feedback) GetFeedback() string {
+	if f == nil || f.Feedback == nil {
+		return ""
+	}
+	return *f.Feedback
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (f *Feedback) GetId() int {
+	if f == nil || f.Id == nil {
+		return 0
+	}
+	return *f.Id
+}
+
+// GetRating returns the Rating field if it's non-nil, zero value otherwise.
+func (f *Feedback) GetRating() int {
+	if f == nil || f.Rating == nil {
+		return 0
+	}
+	return *f.Rating
+}
+
+// GetScreen returns the Screen field if it's non-nil, zero value otherwise.
+func (f *Feedback) GetScreen() string {
+	if f == nil || f.Screen == nil {
+		return ""
+	}
+	return *f.Screen
+}
+
+// GetTime returns the Time field if it's non-nil, zero value otherwise.
+func (f *Feedback) GetTime() DateTime {
+	if f == nil || f.Time == nil {
+		return DateTime{}
+	}
+	return *f.Time
+}
+
+// GetApp returns the App field if it's non-nil, zero value otherwise.
+func (f *FeedbackRequest) GetApp() string {
+	if f == nil || f.App == nil {
+		return ""
+	}
+	return *f.App
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (f *FeedbackRequest) GetEmail() string {
+	if f == nil || f.Email == nil {
+		return ""
+	}
+	return *f.Email
+}
+
+// GetFeedback returns the Feedback field if it's non-nil, zero value otherwise.
+func (f *FeedbackRequest) GetFeedback() string {
+	if f == nil || f.Feedback == nil {
+		return ""
+	}
+	return *f.Feedback
+}
+
+// GetRating returns the Rating field if it's non-nil, zero value otherwise.
+func (f *FeedbackRequest) GetRating() int {
+	if f == nil || f.Rating == nil {
+		return 0
+	}
+	return *f.Rating
+}
+
+// GetScreen returns the Screen field if it's non-nil, zero value otherwise.
+func (f *FeedbackRequest) GetScreen() string {
+	if f == nil || f.Screen == nil {
+		return ""
+	}
+	return *f.Screen
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (f *FieldNotice) GetDeviceId() int {
+	if f == nil || f.DeviceId == nil {
+		return 0
+	}
+	return *f.DeviceId
+}
+
+// GetFieldNoticeId returns the FieldNoticeId field if it's non-nil, zero value otherwise.
+func (f *FieldNotice) GetFieldNoticeId() string {
+	if f == nil || f.FieldNoticeId == nil {
+		return ""
+	}
+	return *f.FieldNoticeId
+}
+
+// GetMatchConfidence returns the MatchConfidence field if it's non-nil, zero value otherwise.
+func (f *FieldNotice) GetMatchConfidence() string {
+	if f == nil || f.MatchConfidence == nil {
+		return ""
+	}
+	return *f.MatchConfidence
+}
+
+// GetMatchConfidenceReason returns the MatchConfidenceReason field if it's non-nil, zero value otherwise.
+func (f *FieldNotice) GetMatchConfidenceReason() string {
+	if f == nil || f.MatchConfidenceReason == nil {
+		return ""
+	}
+	return *f.MatchConfidenceReason
+}
+
+// GetPhysicalElementId returns the PhysicalElementId field if it's non-nil, zero value otherwise.
+func (f *FieldNotice) GetPhysicalElementId() int {
+	if f == nil || f.PhysicalElementId == nil {
+		return 0
+	}
+	return *f.PhysicalElementId
+}
+
+// GetBulletinFirstPublished returns the BulletinFirstPublished field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetBulletinFirstPublished() Timestamp {
+	if f == nil || f.BulletinFirstPublished == nil {
+		return Timestamp{}
+	}
+	return *f.BulletinFirstPublished
+}
+
+// GetBulletinLastUpdated returns the BulletinLastUpdated field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetBulletinLastUpdated() DateTime {
+	if f == nil || f.BulletinLastUpdated == nil {
+		return DateTime{}
+	}
+	return *f.BulletinLastUpdated
+}
+
+// GetBulletinMappingCaveat returns the BulletinMappingCaveat field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetBulletinMappingCaveat() string {
+	if f == nil || f.BulletinMappingCaveat == nil {
+		return ""
+	}
+	return *f.BulletinMappingCaveat
+}
+
+// GetBulletinTitle returns the BulletinTitle field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetBulletinTitle() string {
+	if f == nil || f.BulletinTitle == nil {
+		return ""
+	}
+	return *f.BulletinTitle
+}
+
+// GetBulletinUrl returns the BulletinUrl field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetBulletinUrl() string {
+	if f == nil || f.BulletinUrl == nil {
+		return ""
+	}
+	return *f.BulletinUrl
+}
+
+// GetFieldNoticeId returns the FieldNoticeId field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetFieldNoticeId() string {
+	if f == nil || f.FieldNoticeId == nil {
+		return ""
+	}
+	return *f.FieldNoticeId
+}
+
+// GetFnType returns the FnType field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetFnType() string {
+	if f == nil || f.FnType == nil {
+		return ""
+	}
+	return *f.FnType
+}
+
+// GetProblemDescription returns the ProblemDescription field if it's non-nil, zero value otherwise.
+func (f *FNBulletin) GetProblemDescription() string {
+	if f == nil || f.ProblemDescription == nil {
+		return ""
+	}
+	return *f.ProblemDescription
+}
+
+// GetCurrentEoxMilestone returns the CurrentEoxMilestone field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetCurrentEoxMilestone() string {
+	if h == nil || h.CurrentEoxMilestone == nil {
+		return ""
+	}
+	return *h.CurrentEoxMilestone
+}
+
+// GetCurrentEoxMilestoneDate returns the CurrentEoxMilestoneDate field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetCurrentEoxMilestoneDate() DateTime {
+	if h == nil || h.CurrentEoxMilestoneDate == nil {
+		return DateTime{}
+	}
+	return *h.CurrentEoxMilestoneDate
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetDeviceId() int {
+	if h == nil || h.DeviceId == nil {
+		return 0
+	}
+	return *h.DeviceId
+}
+
+// GetDeviceName returns the DeviceName field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetDeviceName() string {
+	if h == nil || h.DeviceName == nil {
+		return ""
+	}
+	return *h.DeviceName
+}
+
+// GetHwEoxId returns the HwEoxId field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetHwEoxId() int {
+	if h == nil || h.HwEoxId == nil {
+		return 0
+	}
+	return *h.HwEoxId
+}
+
+// GetNextEoxMilestone returns the NextEoxMilestone field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetNextEoxMilestone() string {
+	if h == nil || h.NextEoxMilestone == nil {
+		return ""
+	}
+	return *h.NextEoxMilestone
+}
+
+// GetNextEoxMilestoneDate returns the NextEoxMilestoneDate field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetNextEoxMilestoneDate() DateTime {
+	if h == nil || h.NextEoxMilestoneDate == nil {
+		return DateTime{}
+	}
+	return *h.NextEoxMilestoneDate
+}
+
+// GetPhysicalElementId returns the PhysicalElementId field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetPhysicalElementId() int {
+	if h == nil || h.PhysicalElementId == nil {
+		return 0
+	}
+	return *h.PhysicalElementId
+}
+
+// GetPhysicalType returns the PhysicalType field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetPhysicalType() string {
+	if h == nil || h.PhysicalType == nil {
+		return ""
+	}
+	return *h.PhysicalType
+}
+
+// GetProductId returns the ProductId field if it's non-nil, zero value otherwise.
+func (h *HWEOX) GetProductId() string {
+	if h == nil || h.ProductId == nil {
+		return ""
+	}
+	return *h.ProductId
+}
+
+// GetBulletinNumber returns the BulletinNumber field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetBulletinNumber() string {
+	if h == nil || h.BulletinNumber == nil {
+		return ""
+	}
+	return *h.BulletinNumber
+}
+
+// GetBulletinTitle returns the BulletinTitle field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetBulletinTitle() string {
+	if h == nil || h.BulletinTitle == nil {
+		return ""
+	}
+	return *h.BulletinTitle
+}
+
+// GetBulletinUrl returns the BulletinUrl field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetBulletinUrl() string {
+	if h == nil || h.BulletinUrl == nil {
+		return ""
+	}
+	return *h.BulletinUrl
+}
+
+// GetEoLifeAnnouncementDate returns the EoLifeAnnouncementDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetEoLifeAnnouncementDate() DateTime {
+	if h == nil || h.EoLifeAnnouncementDate == nil {
+		return DateTime{}
+	}
+	return *h.EoLifeAnnouncementDate
+}
+
+// GetEoNewServiceAttachDate returns the EoNewServiceAttachDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetEoNewServiceAttachDate() DateTime {
+	if h == nil || h.EoNewServiceAttachDate == nil {
+		return DateTime{}
+	}
+	return *h.EoNewServiceAttachDate
+}
+
+// GetEoRoutineFailureAnalysisDate returns the EoRoutineFailureAnalysisDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetEoRoutineFailureAnalysisDate() DateTime {
+	if h == nil || h.EoRoutineFailureAnalysisDate == nil {
+		return DateTime{}
+	}
+	return *h.EoRoutineFailureAnalysisDate
+}
+
+// GetEoSaleDate returns the EoSaleDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetEoSaleDate() DateTime {
+	if h == nil || h.EoSaleDate == nil {
+		return DateTime{}
+	}
+	return *h.EoSaleDate
+}
+
+// GetEoSecurityVulSupportDate returns the EoSecurityVulSupportDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetEoSecurityVulSupportDate() DateTime {
+	if h == nil || h.EoSecurityVulSupportDate == nil {
+		return DateTime{}
+	}
+	return *h.EoSecurityVulSupportDate
+}
+
+// GetEoSoftwareContractRenewalDate returns the EoSoftwareContractRenewalDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetEoSoftwareContractRenewalDate() DateTime {
+	if h == nil || h.EoSoftwareContractRenewalDate == nil {
+		return DateTime{}
+	}
+	return *h.EoSoftwareContractRenewalDate
+}
+
+// GetEoSwMaintenanceReleasesDate returns the EoSwMaintenanceReleasesDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetEoSwMaintenanceReleasesDate() DateTime {
+	if h == nil || h.EoSwMaintenanceReleasesDate == nil {
+		return DateTime{}
+	}
+	return *h.EoSwMaintenanceReleasesDate
+}
+
+// GetHwEoxId returns the HwEoxId field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetHwEoxId() int {
+	if h == nil || h.HwEoxId == nil {
+		return 0
+	}
+	return *h.HwEoxId
+}
+
+// GetLastDateOfSupport returns the LastDateOfSupport field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetLastDateOfSupport() DateTime {
+	if h == nil || h.LastDateOfSupport == nil {
+		return DateTime{}
+	}
+	return *h.LastDateOfSupport
+}
+
+// GetLastShipDate returns the LastShipDate field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetLastShipDate() DateTime {
+	if h == nil || h.LastShipDate == nil {
+		return DateTime{}
+	}
+	return *h.LastShipDate
+}
+
+// GetProductId returns the ProductId field if it's non-nil, zero value otherwise.
+func (h *HWEOXBulletin) GetProductId() string {
+	if h == nil || h.ProductId == nil {
+		return ""
+	}
+	return *h.ProductId
+}
+
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (i *ItemCount) GetCount() int {
+	if i == nil || i.Count == nil {
+		return 0
+	}
+	return *i.Count
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (i *ItemCount) GetDate() Date {
+	if i == nil || i.Date == nil {
+		return Date{}
+	}
+	return *i.Date
+}
+
+// GetTableName returns the TableName field if it's non-nil, zero value otherwise.
+func (i *ItemCount) GetTableName() string {
+	if i == nil || i.TableName == nil {
+		return ""
+	}
+	return *i.TableName
+}
+
+// GetItemDescription returns the ItemDescription field if it's non-nil, zero value otherwise.
+func (o *OrderablePid) GetItemDescription() string {
+	if o == nil || o.ItemDescription == nil {
+		return ""
+	}
+	return *o.ItemDescription
+}
+
+// GetItemPosition returns the ItemPosition field if it's non-nil, zero value otherwise.
+func (o *OrderablePid) GetItemPosition() string {
+	if o == nil || o.ItemPosition == nil {
+		return ""
+	}
+	return *o.ItemPosition
+}
+
+// GetItemType returns the ItemType field if it's non-nil, zero value otherwise.
+func (o *OrderablePid) GetItemType() string {
+	if o == nil || o.ItemType == nil {
+		return ""
+	}
+	return *o.ItemType
+}
+
+// GetOrderablePid returns the OrderablePid field if it's non-nil, zero value otherwise.
+func (o *OrderablePid) GetOrderablePid() string {
+	if o == nil || o.OrderablePid == nil {
+		return ""
+	}
+	return *o.OrderablePid
+}
+
+// GetPillarCode returns the PillarCode field if it's non-nil, zero value otherwise.
+func (o *OrderablePid) GetPillarCode() string {
+	if o == nil || o.PillarCode == nil {
+		return ""
+	}
+	return *o.PillarCode
+}
+
+// GetPage returns the Page field if it's non-nil, zero value otherwise.
+func (p *Page) GetPage() int {
+	if p == nil || p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+// GetPages returns the Pages field if it's non-nil, zero value otherwise.
+func (p *Page) GetPages() int {
+	if p == nil || p.Pages == nil {
+		return 0
+	}
+	return *p.Pages
+}
+
+// GetPerPage returns the PerPage field if it's non-nil, zero value otherwise.
+func (p *Page) GetPerPage() int {
+	if p == nil || p.PerPage == nil {
+		return 0
+	}
+	return *p.PerPage
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (p *Page) GetTotal() int {
+	if p == nil || p.Total == nil {
+		return 0
+	}
+	return *p.Total
+}
+
+// GetBulletinFirstPublished returns the BulletinFirstPublished field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetBulletinFirstPublished() Timestamp {
+	if p == nil || p.BulletinFirstPublished == nil {
+		return Timestamp{}
+	}
+	return *p.BulletinFirstPublished
+}
+
+// GetBulletinLastUpdated returns the BulletinLastUpdated field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetBulletinLastUpdated() DateTime {
+	if p == nil || p.BulletinLastUpdated == nil {
+		return DateTime{}
+	}
+	return *p.BulletinLastUpdated
+}
+
+// GetBulletinMappingCaveat returns the BulletinMappingCaveat field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetBulletinMappingCaveat() string {
+	if p == nil || p.BulletinMappingCaveat == nil {
+		return ""
+	}
+	return *p.BulletinMappingCaveat
+}
+
+// GetBulletinSummary returns the BulletinSummary field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetBulletinSummary() string {
+	if p == nil || p.BulletinSummary == nil {
+		return ""
+	}
+	return *p.BulletinSummary
+}
+
+// GetBulletinTitle returns the BulletinTitle field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetBulletinTitle() string {
+	if p == nil || p.BulletinTitle == nil {
+		return ""
+	}
+	return *p.BulletinTitle
+}
+
+// GetBulletinUrl returns the BulletinUrl field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetBulletinUrl() string {
+	if p == nil || p.BulletinUrl == nil {
+		return ""
+	}
+	return *p.BulletinUrl
+}
+
+// GetBulletinVersion returns the BulletinVersion field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetBulletinVersion() string {
+	if p == nil || p.BulletinVersion == nil {
+		return ""
+	}
+	return *p.BulletinVersion
+}
+
+// GetCiscoBugIds returns the CiscoBugIds field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetCiscoBugIds() string {
+	if p == nil || p.CiscoBugIds == nil {
+		return ""
+	}
+	return *p.CiscoBugIds
+}
+
+// GetCveId returns the CveId field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetCveId() string {
+	if p == nil || p.CveId == nil {
+		return ""
+	}
+	return *p.CveId
+}
+
+// GetCvssBase returns the CvssBase field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetCvssBase() string {
+	if p == nil || p.CvssBase == nil {
+		return ""
+	}
+	return *p.CvssBase
+}
+
+// GetCvssTemporal returns the CvssTemporal field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetCvssTemporal() string {
+	if p == nil || p.CvssTemporal == nil {
+		return ""
+	}
+	return *p.CvssTemporal
+}
+
+// GetPsirtAdvisoryId returns the PsirtAdvisoryId field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetPsirtAdvisoryId() string {
+	if p == nil || p.PsirtAdvisoryId == nil {
+		return ""
+	}
+	return *p.PsirtAdvisoryId
+}
+
+// GetPsirtColdId returns the PsirtColdId field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetPsirtColdId() int {
+	if p == nil || p.PsirtColdId == nil {
+		return 0
+	}
+	return *p.PsirtColdId
+}
+
+// GetSir returns the Sir field if it's non-nil, zero value otherwise.
+func (p *PSIRTBulletin) GetSir() string {
+	if p == nil || p.Sir == nil {
+		return ""
+	}
+	return *p.Sir
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetDeviceId() int {
+	if s == nil || s.DeviceId == nil {
+		return 0
+	}
+	return *s.DeviceId
+}
+
+// GetMatchConfidence returns the MatchConfidence field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetMatchConfidence() string {
+	if s == nil || s.MatchConfidence == nil {
+		return ""
+	}
+	return *s.MatchConfidence
+}
+
+// GetMatchConfidenceReason returns the MatchConfidenceReason field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetMatchConfidenceReason() string {
+	if s == nil || s.MatchConfidenceReason == nil {
+		return ""
+	}
+	return *s.MatchConfidenceReason
+}
+
+// GetPsirtColdId returns the PsirtColdId field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetPsirtColdId() int {
+	if s == nil || s.PsirtColdId == nil {
+		return 0
+	}
+	return *s.PsirtColdId
+}
+
+// GetContractSiteAddress1 returns the ContractSiteAddress1 field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetContractSiteAddress1() string {
+	if s == nil || s.ContractSiteAddress1 == nil {
+		return ""
+	}
+	return *s.ContractSiteAddress1
+}
+
+// GetContractSiteCity returns the ContractSiteCity field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetContractSiteCity() string {
+	if s == nil || s.ContractSiteCity == nil {
+		return ""
+	}
+	return *s.ContractSiteCity
+}
+
+// GetContractSiteCountry returns the ContractSiteCountry field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetContractSiteCountry() string {
+	if s == nil || s.ContractSiteCountry == nil {
+		return ""
+	}
+	return *s.ContractSiteCountry
+}
+
+// GetContractSiteCustomerName returns the ContractSiteCustomerName field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetContractSiteCustomerName() string {
+	if s == nil || s.ContractSiteCustomerName == nil {
+		return ""
+	}
+	return *s.ContractSiteCustomerName
+}
+
+// GetContractSiteStateProvince returns the ContractSiteStateProvince field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetContractSiteStateProvince() string {
+	if s == nil || s.ContractSiteStateProvince == nil {
+		return ""
+	}
+	return *s.ContractSiteStateProvince
+}
+
+// GetCoveredProductLineEndDate returns the CoveredProductLineEndDate field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetCoveredProductLineEndDate() Date {
+	if s == nil || s.CoveredProductLineEndDate == nil {
+		return Date{}
+	}
+	return *s.CoveredProductLineEndDate
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetId() int {
+	if s == nil || s.Id == nil {
+		return 0
+	}
+	return *s.Id
+}
+
+// GetIsCovered returns the IsCovered field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetIsCovered() string {
+	if s == nil || s.IsCovered == nil {
+		return ""
+	}
+	return *s.IsCovered
+}
+
+// GetParentSrNo returns the ParentSrNo field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetParentSrNo() string {
+	if s == nil || s.ParentSrNo == nil {
+		return ""
+	}
+	return *s.ParentSrNo
+}
+
+// GetServiceContractNumber returns the ServiceContractNumber field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetServiceContractNumber() string {
+	if s == nil || s.ServiceContractNumber == nil {
+		return ""
+	}
+	return *s.ServiceContractNumber
+}
+
+// GetServiceLineDescr returns the ServiceLineDescr field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetServiceLineDescr() string {
+	if s == nil || s.ServiceLineDescr == nil {
+		return ""
+	}
+	return *s.ServiceLineDescr
+}
+
+// GetSrNo returns the SrNo field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetSrNo() string {
+	if s == nil || s.SrNo == nil {
+		return ""
+	}
+	return *s.SrNo
+}
+
+// GetWarrantyEndDate returns the WarrantyEndDate field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetWarrantyEndDate() Date {
+	if s == nil || s.WarrantyEndDate == nil {
+		return Date{}
+	}
+	return *s.WarrantyEndDate
+}
+
+// GetWarrantyType returns the WarrantyType field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetWarrantyType() string {
+	if s == nil || s.WarrantyType == nil {
+		return ""
+	}
+	return *s.WarrantyType
+}
+
+// GetWarrantyTypeDescription returns the WarrantyTypeDescription field if it's non-nil, zero value otherwise.
+func (s *SerialNumberDetails) GetWarrantyTypeDescription() string {
+	if s == nil || s.WarrantyTypeDescription == nil {
+		return ""
+	}
+	return *s.WarrantyTypeDescription
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (s *SoftwareAlert) GetDeviceId() int {
+	if s == nil || s.DeviceId == nil {
+		return 0
+	}
+	return *s.DeviceId
+}
+
+// GetDeviceName returns the DeviceName field if it's non-nil, zero value otherwise.
+func (s *SoftwareAlert) GetDeviceName() string {
+	if s == nil || s.DeviceName == nil {
+		return ""
+	}
+	return *s.DeviceName
+}
+
+// GetImageName returns the ImageName field if it's non-nil, zero value otherwise.
+func (s *SoftwareAlert) GetImageName() string {
+	if s == nil || s.ImageName == nil {
+		return ""
+	}
+	return *s.ImageName
+}
+
+// GetSwAlertType returns the SwAlertType field if it's non-nil, zero value otherwise.
+func (s *SoftwareAlert) GetSwAlertType() string {
+	if s == nil || s.SwAlertType == nil {
+		return ""
+	}
+	return *s.SwAlertType
+}
+
+// GetSwAlertUrl returns the SwAlertUrl field if it's non-nil, zero value otherwise.
+func (s *SoftwareAlert) GetSwAlertUrl() string {
+	if s == nil || s.SwAlertUrl == nil {
+		return ""
+	}
+	return *s.SwAlertUrl
+}
+
+// GetSwType returns the SwType field if it's non-nil, zero value otherwise.
+func (s *SoftwareAlert) GetSwType() string {
+	if s == nil || s.SwType == nil {
+		return ""
+	}
+	return *s.SwType
+}
+
+// GetSwVersion returns the SwVersion field if it's non-nil, zero value otherwise.
+func (s *SoftwareAlert) GetSwVersion() string {
+	if s == nil || s.SwVersion == nil {
+		return ""
+	}
+	return *s.SwVersion
+}
+
+// GetCurrentEoxMilestone returns the CurrentEoxMilestone field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetCurrentEoxMilestone() string {
+	if s == nil || s.CurrentEoxMilestone == nil {
+		return ""
+	}
+	return *s.CurrentEoxMilestone
+}
+
+// GetCurrentEoxMilestoneDate returns the CurrentEoxMilestoneDate field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetCurrentEoxMilestoneDate() DateTime {
+	if s == nil || s.CurrentEoxMilestoneDate == nil {
+		return DateTime{}
+	}
+	return *s.CurrentEoxMilestoneDate
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetDeviceId() int {
+	if s == nil || s.DeviceId == nil {
+		return 0
+	}
+	return *s.DeviceId
+}
+
+// GetDeviceName returns the DeviceName field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetDeviceName() string {
+	if s == nil || s.DeviceName == nil {
+		return ""
+	}
+	return *s.DeviceName
+}
+
+// GetNexteoxMilestone returns the NexteoxMilestone field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetNexteoxMilestone() string {
+	if s == nil || s.NexteoxMilestone == nil {
+		return ""
+	}
+	return *s.NexteoxMilestone
+}
+
+// GetNexteoxMilestoneDate returns the NexteoxMilestoneDate field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetNexteoxMilestoneDate() DateTime {
+	if s == nil || s.NexteoxMilestoneDate == nil {
+		return DateTime{}
+	}
+	return *s.NexteoxMilestoneDate
+}
+
+// GetSwEoxId returns the SwEoxId field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetSwEoxId() int {
+	if s == nil || s.SwEoxId == nil {
+		return 0
+	}
+	return *s.SwEoxId
+}
+
+// GetSwType returns the SwType field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetSwType() string {
+	if s == nil || s.SwType == nil {
+		return ""
+	}
+	return *s.SwType
+}
+
+// GetSwVersion returns the SwVersion field if it's non-nil, zero value otherwise.
+func (s *SoftwareEOX) GetSwVersion() string {
+	if s == nil || s.SwVersion == nil {
+		return ""
+	}
+	return *s.SwVersion
+}
+
+// GetBulletinNumber returns the BulletinNumber field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetBulletinNumber() string {
+	if s == nil || s.BulletinNumber == nil {
+		return ""
+	}
+	return *s.BulletinNumber
+}
+
+// GetBulletinTitle returns the BulletinTitle field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetBulletinTitle() string {
+	if s == nil || s.BulletinTitle == nil {
+		return ""
+	}
+	return *s.BulletinTitle
+}
+
+// GetBulletinUrl returns the BulletinUrl field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetBulletinUrl() string {
+	if s == nil || s.BulletinUrl == nil {
+		return ""
+	}
+	return *s.BulletinUrl
+}
+
+// GetEoLifeAnnouncementDate returns the EoLifeAnnouncementDate field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetEoLifeAnnouncementDate() DateTime {
+	if s == nil || s.EoLifeAnnouncementDate == nil {
+		return DateTime{}
+	}
+	return *s.EoLifeAnnouncementDate
+}
+
+// GetEoSaleDate returns the EoSaleDate field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetEoSaleDate() DateTime {
+	if s == nil || s.EoSaleDate == nil {
+		return DateTime{}
+	}
+	return *s.EoSaleDate
+}
+
+// GetEoSecurityVulSupportDate returns the EoSecurityVulSupportDate field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetEoSecurityVulSupportDate() DateTime {
+	if s == nil || s.EoSecurityVulSupportDate == nil {
+		return DateTime{}
+	}
+	return *s.EoSecurityVulSupportDate
+}
+
+// GetEoSwMaintenanceReleasesDate returns the EoSwMaintenanceReleasesDate field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetEoSwMaintenanceReleasesDate() DateTime {
+	if s == nil || s.EoSwMaintenanceReleasesDate == nil {
+		return DateTime{}
+	}
+	return *s.EoSwMaintenanceReleasesDate
+}
+
+// GetLastDateOfSupport returns the LastDateOfSupport field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetLastDateOfSupport() DateTime {
+	if s == nil || s.LastDateOfSupport == nil {
+		return DateTime{}
+	}
+	return *s.LastDateOfSupport
+}
+
+// GetSwEoxId returns the SwEoxId field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetSwEoxId() int {
+	if s == nil || s.SwEoxId == nil {
+		return 0
+	}
+	return *s.SwEoxId
+}
+
+// GetSwMaintenanceVersion returns the SwMaintenanceVersion field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetSwMaintenanceVersion() string {
+	if s == nil || s.SwMaintenanceVersion == nil {
+		return ""
+	}
+	return *s.SwMaintenanceVersion
+}
+
+// GetSwMajorVersion returns the SwMajorVersion field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetSwMajorVersion() string {
+	if s == nil || s.SwMajorVersion == nil {
+		return ""
+	}
+	return *s.SwMajorVersion
+}
+
+// GetSwTrain returns the SwTrain field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetSwTrain() string {
+	if s == nil || s.SwTrain == nil {
+		return ""
+	}
+	return *s.SwTrain
+}
+
+// GetSwType returns the SwType field if it's non-nil, zero value otherwise.
+func (s *SWEOXBulletin) GetSwType() string {
+	if s == nil || s.SwType == nil {
+		return ""
+	}
+	return *s.SwType
+}
+
+// GetDeviceId returns the DeviceId field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetDeviceId() int {
+	if t == nil || t.DeviceId == nil {
+		return 0
+	}
+	return *t.DeviceId
+}
+
+// GetSmuPieType returns the SmuPieType field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetSmuPieType() string {
+	if t == nil || t.SmuPieType == nil {
+		return ""
+	}
+	return *t.SmuPieType
+}
+
+// GetSwName returns the SwName field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetSwName() string {
+	if t == nil || t.SwName == nil {
+		return ""
+	}
+	return *t.SwName
+}
+
+// GetSwRole returns the SwRole field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetSwRole() string {
+	if t == nil || t.SwRole == nil {
+		return ""
+	}
+	return *t.SwRole
+}
+
+// GetTrackDeviceSmuPieAction returns the TrackDeviceSmuPieAction field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetTrackDeviceSmuPieAction() string {
+	if t == nil || t.TrackDeviceSmuPieAction == nil {
+		return ""
+	}
+	return *t.TrackDeviceSmuPieAction
+}
+
+// GetTrackDeviceSmuPieCompliant returns the TrackDeviceSmuPieCompliant field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetTrackDeviceSmuPieCompliant() string {
+	if t == nil || t.TrackDeviceSmuPieCompliant == nil {
+		return ""
+	}
+	return *t.TrackDeviceSmuPieCompliant
+}
+
+// GetTrackId returns the TrackId field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetTrackId() int {
+	if t == nil || t.TrackId == nil {
+		return 0
+	}
+	return *t.TrackId
+}
+
+// GetTrackName returns the TrackName field if it's non-nil, zero value otherwise.
+func (t *TrackCompliance) GetTrackName() string {
+	if t == nil || t.TrackName == nil {
+		return ""
+	}
+	return *t.TrackName
+}
+
+// GetSwName returns the SwName field if it's non-nil, zero value otherwise.
+func (t *TrackSmupieRecommendation) GetSwName() string {
+	if t == nil || t.SwName == nil {
+		return ""
+	}
+	return *t.SwName
+}
+
+// GetSwRole returns the SwRole field if it's non-nil, zero value otherwise.
+func (t *TrackSmupieRecommendation) GetSwRole() string {
+	if t == nil || t.SwRole == nil {
+		return ""
+	}
+	return *t.SwRole
+}
+
+// GetTrackId returns the TrackId field if it's non-nil, zero value otherwise.
+func (t *TrackSmupieRecommendation) GetTrackId() int {
+	if t == nil || t.TrackId == nil {
+		return 0
+	}
+	return *t.TrackId
+}
+
+// GetTrackName returns the TrackName field if it's non-nil, zero value otherwise.
+func (t *TrackSmupieRecommendation) GetTrackName() string {
+	if t == nil || t.TrackName == nil {
+		return ""
+	}
+	return *t.TrackName
+}
+
+// GetTrackRecHistory returns the TrackRecHistory field if it's non-nil, zero value otherwise.
+func (t *TrackSmupieRecommendation) GetTrackRecHistory() string {
+	if t == nil || t.TrackRecHistory == nil {
+		return ""
+	}
+	return *t.TrackRecHistory
+}
+
+// GetSwType returns the SwType field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetSwType() string {
+	if t == nil || t.SwType == nil {
+		return ""
+	}
+	return *t.SwType
+}
+
+// GetTrackCandidateSwVersion returns the TrackCandidateSwVersion field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackCandidateSwVersion() string {
+	if t == nil || t.TrackCandidateSwVersion == nil {
+		return ""
+	}
+	return *t.TrackCandidateSwVersion
+}
+
+// GetTrackComments returns the TrackComments field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackComments() string {
+	if t == nil || t.TrackComments == nil {
+		return ""
+	}
+	return *t.TrackComments
+}
+
+// GetTrackCompliantDevices returns the TrackCompliantDevices field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackCompliantDevices() int {
+	if t == nil || t.TrackCompliantDevices == nil {
+		return 0
+	}
+	return *t.TrackCompliantDevices
+}
+
+// GetTrackDescription returns the TrackDescription field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackDescription() string {
+	if t == nil || t.TrackDescription == nil {
+		return ""
+	}
+	return *t.TrackDescription
+}
+
+// GetTrackId returns the TrackId field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackId() int {
+	if t == nil || t.TrackId == nil {
+		return 0
+	}
+	return *t.TrackId
+}
+
+// GetTrackLastModifiedDate returns the TrackLastModifiedDate field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackLastModifiedDate() Date {
+	if t == nil || t.TrackLastModifiedDate == nil {
+		return Date{}
+	}
+	return *t.TrackLastModifiedDate
+}
+
+// GetTrackName returns the TrackName field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackName() string {
+	if t == nil || t.TrackName == nil {
+		return ""
+	}
+	return *t.TrackName
+}
+
+// GetTrackNonCompliantDevices returns the TrackNonCompliantDevices field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackNonCompliantDevices() int {
+	if t == nil || t.TrackNonCompliantDevices == nil {
+		return 0
+	}
+	return *t.TrackNonCompliantDevices
+}
+
+// GetTrackPercentCompliant returns the TrackPercentCompliant field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPercentCompliant() float32 {
+	if t == nil || t.TrackPercentCompliant == nil {
+		return 0
+	}
+	return *t.TrackPercentCompliant
+}
+
+// GetTrackPercentFlexibleCompliant returns the TrackPercentFlexibleCompliant field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPercentFlexibleCompliant() float32 {
+	if t == nil || t.TrackPercentFlexibleCompliant == nil {
+		return 0
+	}
+	return *t.TrackPercentFlexibleCompliant
+}
+
+// GetTrackPrev1PieCriteria returns the TrackPrev1PieCriteria field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPrev1PieCriteria() string {
+	if t == nil || t.TrackPrev1PieCriteria == nil {
+		return ""
+	}
+	return *t.TrackPrev1PieCriteria
+}
+
+// GetTrackPrev1SmuCriteria returns the TrackPrev1SmuCriteria field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPrev1SmuCriteria() string {
+	if t == nil || t.TrackPrev1SmuCriteria == nil {
+		return ""
+	}
+	return *t.TrackPrev1SmuCriteria
+}
+
+// GetTrackPrev2PieCriteria returns the TrackPrev2PieCriteria field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPrev2PieCriteria() string {
+	if t == nil || t.TrackPrev2PieCriteria == nil {
+		return ""
+	}
+	return *t.TrackPrev2PieCriteria
+}
+
+// GetTrackPrev2SmuCriteria returns the TrackPrev2SmuCriteria field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPrev2SmuCriteria() string {
+	if t == nil || t.TrackPrev2SmuCriteria == nil {
+		return ""
+	}
+	return *t.TrackPrev2SmuCriteria
+}
+
+// GetTrackPrevCompliantDevices returns the TrackPrevCompliantDevices field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPrevCompliantDevices() int {
+	if t == nil || t.TrackPrevCompliantDevices == nil {
+		return 0
+	}
+	return *t.TrackPrevCompliantDevices
+}
+
+// GetTrackPrevSwVersion1 returns the TrackPrevSwVersion1 field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPrevSwVersion1() string {
+	if t == nil || t.TrackPrevSwVersion1 == nil {
+		return ""
+	}
+	return *t.TrackPrevSwVersion1
+}
+
+// GetTrackPrevSwVersion2 returns the TrackPrevSwVersion2 field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackPrevSwVersion2() string {
+	if t == nil || t.TrackPrevSwVersion2 == nil {
+		return ""
+	}
+	return *t.TrackPrevSwVersion2
+}
+
+// GetTrackRating returns the TrackRating field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackRating() string {
+	if t == nil || t.TrackRating == nil {
+		return ""
+	}
+	return *t.TrackRating
+}
+
+// GetTrackRecommendationDate returns the TrackRecommendationDate field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackRecommendationDate() Date {
+	if t == nil || t.TrackRecommendationDate == nil {
+		return Date{}
+	}
+	return *t.TrackRecommendationDate
+}
+
+// GetTrackSmuCompliancePercent returns the TrackSmuCompliancePercent field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackSmuCompliancePercent() float32 {
+	if t == nil || t.TrackSmuCompliancePercent == nil {
+		return 0
+	}
+	return *t.TrackSmuCompliancePercent
+}
+
+// GetTrackStandardPieCriteria returns the TrackStandardPieCriteria field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackStandardPieCriteria() string {
+	if t == nil || t.TrackStandardPieCriteria == nil {
+		return ""
+	}
+	return *t.TrackStandardPieCriteria
+}
+
+// GetTrackStandardSmuCount returns the TrackStandardSmuCount field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackStandardSmuCount() int {
+	if t == nil || t.TrackStandardSmuCount == nil {
+		return 0
+	}
+	return *t.TrackStandardSmuCount
+}
+
+// GetTrackStandardSmuCriteria returns the TrackStandardSmuCriteria field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackStandardSmuCriteria() string {
+	if t == nil || t.TrackStandardSmuCriteria == nil {
+		return ""
+	}
+	return *t.TrackStandardSmuCriteria
+}
+
+// GetTrackStandardSwVersion returns the TrackStandardSwVersion field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackStandardSwVersion() string {
+	if t == nil || t.TrackStandardSwVersion == nil {
+		return ""
+	}
+	return *t.TrackStandardSwVersion
+}
+
+// GetTrackStatus returns the TrackStatus field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackStatus() string {
+	if t == nil || t.TrackStatus == nil {
+		return ""
+	}
+	return *t.TrackStatus
+}
+
+// GetTrackTotalDevices returns the TrackTotalDevices field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackTotalDevices() int {
+	if t == nil || t.TrackTotalDevices == nil {
+		return 0
+	}
+	return *t.TrackTotalDevices
+}
+
+// GetTrackTotalSwVersions returns the TrackTotalSwVersions field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackTotalSwVersions() int {
+	if t == nil || t.TrackTotalSwVersions == nil {
+		return 0
+	}
+	return *t.TrackTotalSwVersions
+}
+
+// GetTrackUpgradeReason returns the TrackUpgradeReason field if it's non-nil, zero value otherwise.
+func (t *TrackSummary) GetTrackUpgradeReason() string {
+	if t == nil || t.TrackUpgradeReason == nil {
+		return ""
+	}
+	return *t.TrackUpgradeReason
+}
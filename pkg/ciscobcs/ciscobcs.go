@@ -1,9 +1,12 @@
 package ciscobcs
 
+//go:generate go run ../../cmd/convert-swagger-to-openapi -swagger ../../cmd/convert-swagger-to-openapi/swagger.json -openapi ../../cmd/convert-swagger-to-openapi/openapi.json -models models_gen.go -services services_gen.go
+//go:generate go run gen-accessors.go
+
 import (
 	"context"
 	"encoding/json"
-	"io"
+	"io/ioutil"
 	"net/http"
 	"time"
 
@@ -21,6 +24,15 @@ type Client struct {
 	//API Key for Cisco BCS.
 	APIKey string
 
+	// NormalizeResults replaces placeholder values such as "Missing" in all results with nil,
+	// using Normalize, before they are returned.
+	NormalizeResults bool
+
+	// Validator, when set, validates each of the JSON responses against the API specification and
+	// reports any differences, such as a field whose type has changed, as warnings.  This is intended
+	// for use in testing or staging.  See LoadResponseValidator.
+	Validator *ResponseValidator
+
 	// Services for accessing the various endpoints
 
 	BulkService                      *BulkService
@@ -146,6 +158,10 @@ func Int(v int) *int { return &v }
 // to store v and returns a pointer to it.
 func Int64(v int64) *int64 { return &v }
 
+// Float32 is a helper routine that allocates a new float32 value
+// to store v and returns a pointer to it.
+func Float32(v float32) *float32 { return &v }
+
 // Float64 is a helper routine that allocates a new Float64 value
 // to store v and returns a pointer to it.
 func Float64(v float64) *float64 { return &v }
@@ -154,24 +170,8 @@ func Float64(v float64) *float64 { return &v }
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
 
-// makeRequestToWriter provides a single function to add common items to the request.
-// It will copy the contents of the body to the io.Writer provided in w.
-func (c *Client) makeRequestToWriter(ctx context.Context, req *http.Request, w io.Writer) error {
-	req.Header.Add("x-api-key", c.APIKey)
-	rc := req.WithContext(ctx)
-	res, err := c.HTTPClient.Do(rc)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-	if _, err := io.Copy(w, res.Body); err != nil {
-		return err
-	}
-	return nil
-}
-
 // makeRequest provides a single function to add common items to the request.
-// It will unmarshall the json body to interface provided in v.
+// It will unmarshall the json body to interface provided in v, unless v is nil.
 func (c *Client) makeRequest(ctx context.Context, req *http.Request, v interface{}) error {
 	req.Header.Add("x-api-key", c.APIKey)
 	if !c.lim.Allow() {
@@ -183,27 +183,26 @@ func (c *Client) makeRequest(ctx context.Context, req *http.Request, v interface
 		return err
 	}
 	defer res.Body.Close()
-	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		var ciscobcsErr error
-		switch res.StatusCode {
-		case 400:
-			ciscobcsErr = ErrBadRequest
-		case 401:
-			ciscobcsErr = ErrUnauthorized
-		case 403:
-			ciscobcsErr = ErrForbidden
-		case 500:
-			ciscobcsErr = ErrInternalError
-		default:
-			ciscobcsErr = ErrUnknown
-		}
-		return ciscobcsErr
+	if err := checkResponse(res); err != nil {
+		return err
 	}
-	if res.StatusCode == http.StatusCreated {
+	if v == nil || res.StatusCode == http.StatusNoContent {
 		return nil
 	}
-	if err = json.NewDecoder(res.Body).Decode(&v); err != nil {
+	if c.Validator != nil {
+		body, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return err
+		}
+		c.Validator.validate(req, res.StatusCode, body)
+		if err = json.Unmarshal(body, v); err != nil {
+			return err
+		}
+	} else if err = json.NewDecoder(res.Body).Decode(v); err != nil {
 		return err
 	}
+	if c.NormalizeResults {
+		Normalize(v)
+	}
 	return nil
 }
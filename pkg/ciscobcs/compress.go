@@ -0,0 +1,120 @@
+package ciscobcs
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression represents a compression format supported for bulk data.
+type Compression string
+
+// Supported compression formats.
+const (
+	CompressionNone Compression = ""
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+// acceptEncoding is sent to the bulk endpoint to indicate the compressed encodings we can decode.
+const acceptEncoding = "gzip, zstd"
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// ParseCompression returns the Compression for the given name, e.g. from a command line flag.
+// An empty string or "none" both return CompressionNone.
+func ParseCompression(name string) (Compression, error) {
+	switch c := Compression(strings.ToLower(name)); c {
+	case CompressionNone, "none":
+		return CompressionNone, nil
+	case CompressionGzip, CompressionZstd:
+		return c, nil
+	}
+	return CompressionNone, ErrUnsupportedCompression
+}
+
+// Extension returns the conventional file extension for the compression format, including
+// the leading dot, or an empty string for CompressionNone.
+func (c Compression) Extension() string {
+	switch c {
+	case CompressionGzip:
+		return ".gz"
+	case CompressionZstd:
+		return ".zst"
+	}
+	return ""
+}
+
+// NewDecompressingReader returns a reader for the uncompressed contents of r, detecting
+// gzip or zstd compression from the magic bytes at the start of the stream.  Uncompressed
+// input is returned as is.
+func NewDecompressingReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return decompressor(br, CompressionGzip)
+	case bytes.HasPrefix(magic, zstdMagic):
+		return decompressor(br, CompressionZstd)
+	}
+	return ioutil.NopCloser(br), nil
+}
+
+// NewCompressingWriter returns a writer that compresses to w using the given format.  The
+// returned writer must be closed to flush any remaining data, but closing it does not close w.
+func NewCompressingWriter(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case CompressionNone:
+		return nopWriteCloser{w}, nil
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+	}
+	return nil, ErrUnsupportedCompression
+}
+
+// decompressor returns a reader for the uncompressed contents of r in the given format.
+func decompressor(r io.Reader, c Compression) (io.ReadCloser, error) {
+	switch c {
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	case CompressionZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	}
+	return nil, ErrUnsupportedCompression
+}
+
+// decodedBody returns a reader for the response body with any Content-Encoding removed.
+func decodedBody(res *http.Response) (io.ReadCloser, error) {
+	switch enc := strings.ToLower(res.Header.Get("Content-Encoding")); enc {
+	case "", "identity":
+		return res.Body, nil
+	case "gzip", "zstd":
+		return decompressor(res.Body, Compression(enc))
+	}
+	return nil, ErrUnsupportedCompression
+}
+
+// nopWriteCloser adds a no-op Close method to an io.Writer.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
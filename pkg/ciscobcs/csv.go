@@ -0,0 +1,88 @@
+package ciscobcs
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// CSVWriter writes records as CSV with a column for each of the fields selected by a mask, so
+// that exports contain only the columns required.  The header is written with the first record,
+// and every record must be of the same type.  Values are written as they appear in the JSON,
+// without the quotes for a string, and nil values are written as empty columns.  Output is
+// buffered, so Flush must be called once all records have been written.
+type CSVWriter struct {
+	w       *csv.Writer
+	mask    Mask
+	t       reflect.Type
+	columns []maskColumn
+	tags    DeviceTags
+	names   []string
+}
+
+// NewCSVWriter returns a new CSVWriter that writes the fields selected by mask to w.  An empty
+// mask selects every field.
+func NewCSVWriter(w io.Writer, mask Mask) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), mask: mask}
+}
+
+// SetTags adds a column for each of the named tags of the devices, after the fields selected by
+// the mask, e.g. tag:site, so that exports can be grouped by the derived tags.  It must be called
+// before the first record is written, and has no effect for records other than devices.
+func (cw *CSVWriter) SetTags(tags DeviceTags, names ...string) {
+	cw.tags, cw.names = tags, names
+}
+
+// Write writes a single record.  The record can be any of the model types, e.g. Device or
+// PSIRTBulletin, either as a value or a pointer.  An error wrapping ErrUnknownMaskField is
+// returned where the mask has a field which the record does not.
+func (cw *CSVWriter) Write(v interface{}) error {
+	if rec, ok := v.(BulkRecord); ok {
+		v = rec.Value
+	}
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if cw.t == nil {
+		columns, err := cw.mask.columns(t)
+		if err != nil {
+			return err
+		}
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = c.name
+		}
+		if t != reflect.TypeOf(Device{}) {
+			cw.names = nil
+		}
+		for _, name := range cw.names {
+			header = append(header, TagFieldPrefix+name)
+		}
+		if err := cw.w.Write(header); err != nil {
+			return err
+		}
+		cw.t, cw.columns = t, columns
+	} else if t != cw.t {
+		return fmt.Errorf("%w: %v after %v", ErrUnrecognisedBulkType, t, cw.t)
+	}
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	row := make([]string, len(cw.columns), len(cw.columns)+len(cw.names))
+	for i, c := range cw.columns {
+		row[i], _ = jsonFieldString(rv.Field(c.index))
+	}
+	if len(cw.names) > 0 {
+		d := rv.Interface().(Device)
+		for _, name := range cw.names {
+			row = append(row, cw.tags.Tag(&d, name))
+		}
+	}
+	return cw.w.Write(row)
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+func (cw *CSVWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
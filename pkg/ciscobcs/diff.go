@@ -0,0 +1,313 @@
+package ciscobcs
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DiffIgnoredDeviceFields holds the json names of the device fields which are not compared by
+// DiffBulk, since they change on every collection.
+var DiffIgnoredDeviceFields = map[string]bool{
+	"inventoryTime": true,
+}
+
+// diffIgnoredTrackFields holds the track summary fields which are not compared by DiffBulk, since
+// they are derived from the devices rather than being part of the recommendation.
+var diffIgnoredTrackFields = map[string]bool{
+	"trackCompliantDevices":         true,
+	"trackNonCompliantDevices":      true,
+	"trackPrevCompliantDevices":     true,
+	"trackTotalDevices":             true,
+	"trackTotalSwVersions":          true,
+	"trackPercentCompliant":         true,
+	"trackPercentFlexibleCompliant": true,
+	"trackSmuCompliancePercent":     true,
+}
+
+// FieldChange describes a change to a single field of a record.
+type FieldChange struct {
+	// Field is the json name of the field, e.g. swVersion.
+	Field string `json:"field"`
+
+	// Old and New hold the values of the field, with an empty string for a missing value.
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// DeviceChange holds the changes to a device present in both sets of results.
+type DeviceChange struct {
+	DeviceId int           `json:"deviceId"`
+	Device   Device        `json:"device"`
+	Changes  []FieldChange `json:"changes"`
+}
+
+// BulletinChange holds the changes to a PSIRT or field notice bulletin present in both sets of
+// results whose BulletinLastUpdated has changed.
+type BulletinChange struct {
+	// Id is the PsirtAdvisoryId or FieldNoticeId of the bulletin.
+	Id      string        `json:"id"`
+	Title   string        `json:"title"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// TrackChange holds the changes to the recommendation of a software track present in both sets
+// of results.
+type TrackChange struct {
+	TrackId   int           `json:"trackId"`
+	TrackName string        `json:"trackName"`
+	Changes   []FieldChange `json:"changes,omitempty"`
+
+	// AddedRecommendations and RemovedRecommendations hold the changes to the SMU and PIE
+	// recommendations for the track.
+	AddedRecommendations   []TrackSmupieRecommendation `json:"addedRecommendations,omitempty"`
+	RemovedRecommendations []TrackSmupieRecommendation `json:"removedRecommendations,omitempty"`
+}
+
+// BulkDiff holds the differences between two sets of bulk results, e.g. from consecutive daily
+// downloads.  Each slice is ordered by the key of its records.
+type BulkDiff struct {
+	AddedDevices   []Device       `json:"addedDevices"`
+	RemovedDevices []Device       `json:"removedDevices"`
+	ChangedDevices []DeviceChange `json:"changedDevices"`
+
+	NewPSIRTBulletins     []PSIRTBulletin  `json:"newPsirtBulletins"`
+	UpdatedPSIRTBulletins []BulletinChange `json:"updatedPsirtBulletins"`
+	NewFNBulletins        []FNBulletin     `json:"newFnBulletins"`
+	UpdatedFNBulletins    []BulletinChange `json:"updatedFnBulletins"`
+
+	AddedTracks   []TrackSummary `json:"addedTracks"`
+	RemovedTracks []TrackSummary `json:"removedTracks"`
+	ChangedTracks []TrackChange  `json:"changedTracks"`
+}
+
+// Empty reports whether there are no differences.
+func (d *BulkDiff) Empty() bool {
+	return len(d.AddedDevices) == 0 && len(d.RemovedDevices) == 0 && len(d.ChangedDevices) == 0 &&
+		len(d.NewPSIRTBulletins) == 0 && len(d.UpdatedPSIRTBulletins) == 0 &&
+		len(d.NewFNBulletins) == 0 && len(d.UpdatedFNBulletins) == 0 &&
+		len(d.AddedTracks) == 0 && len(d.RemovedTracks) == 0 && len(d.ChangedTracks) == 0
+}
+
+// DiffBulk returns the differences between the earlier results, prev, and the later, next.
+//
+// Devices are matched by DeviceId and reported as added, removed or changed, with the changes to
+// each field other than those in DiffIgnoredDeviceFields.  PSIRT bulletins are matched by
+// PsirtAdvisoryId and field notices by FieldNoticeId, and are reported as new, or as updated
+// where their BulletinLastUpdated has changed.  Where an advisory is listed more than once, e.g.
+// for different software types, the first listing is compared.  Software tracks are matched by
+// TrackId and report changes to their recommended versions and SMU and PIE recommendations, but
+// not to the compliance figures, which change with the devices.
+func DiffBulk(prev, next *BulkResults) *BulkDiff {
+	d := &BulkDiff{
+		AddedDevices:          []Device{},
+		RemovedDevices:        []Device{},
+		ChangedDevices:        []DeviceChange{},
+		NewPSIRTBulletins:     []PSIRTBulletin{},
+		UpdatedPSIRTBulletins: []BulletinChange{},
+		NewFNBulletins:        []FNBulletin{},
+		UpdatedFNBulletins:    []BulletinChange{},
+		AddedTracks:           []TrackSummary{},
+		RemovedTracks:         []TrackSummary{},
+		ChangedTracks:         []TrackChange{},
+	}
+	diffDevices(d, prev.Devices, next.Devices)
+	diffPSIRTBulletins(d, prev.PSIRTBulletins, next.PSIRTBulletins)
+	diffFNBulletins(d, prev.FNBulletins, next.FNBulletins)
+	diffTracks(d, prev, next)
+	return d
+}
+
+// diffDevices adds the differences between the prev and next devices to d.
+func diffDevices(d *BulkDiff, prev, next []Device) {
+	prevByID := make(map[int]Device)
+	for _, v := range prev {
+		prevByID[v.GetDeviceId()] = v
+	}
+	nextByID := make(map[int]bool)
+	for _, v := range next {
+		id := v.GetDeviceId()
+		nextByID[id] = true
+		o, ok := prevByID[id]
+		if !ok {
+			d.AddedDevices = append(d.AddedDevices, v)
+			continue
+		}
+		if changes := fieldChanges(o, v, DiffIgnoredDeviceFields); len(changes) > 0 {
+			d.ChangedDevices = append(d.ChangedDevices, DeviceChange{DeviceId: id, Device: v, Changes: changes})
+		}
+	}
+	for _, v := range prev {
+		if !nextByID[v.GetDeviceId()] {
+			d.RemovedDevices = append(d.RemovedDevices, v)
+		}
+	}
+	sort.SliceStable(d.AddedDevices, func(i, j int) bool { return d.AddedDevices[i].GetDeviceId() < d.AddedDevices[j].GetDeviceId() })
+	sort.SliceStable(d.RemovedDevices, func(i, j int) bool { return d.RemovedDevices[i].GetDeviceId() < d.RemovedDevices[j].GetDeviceId() })
+	sort.SliceStable(d.ChangedDevices, func(i, j int) bool { return d.ChangedDevices[i].DeviceId < d.ChangedDevices[j].DeviceId })
+}
+
+// diffPSIRTBulletins adds the new and updated PSIRT bulletins to d.
+func diffPSIRTBulletins(d *BulkDiff, prev, next []PSIRTBulletin) {
+	prevByID := make(map[string]PSIRTBulletin)
+	for _, b := range prev {
+		if id := bulletinKey(b.GetPsirtAdvisoryId()); id != "" {
+			if _, ok := prevByID[id]; !ok {
+				prevByID[id] = b
+			}
+		}
+	}
+	seen := make(map[string]bool)
+	for _, b := range next {
+		id := bulletinKey(b.GetPsirtAdvisoryId())
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		o, ok := prevByID[id]
+		switch {
+		case !ok:
+			d.NewPSIRTBulletins = append(d.NewPSIRTBulletins, b)
+		case !o.GetBulletinLastUpdated().Equal(b.GetBulletinLastUpdated().Time):
+			d.UpdatedPSIRTBulletins = append(d.UpdatedPSIRTBulletins, BulletinChange{
+				Id:      b.GetPsirtAdvisoryId(),
+				Title:   b.GetBulletinTitle(),
+				Changes: fieldChanges(o, b, nil),
+			})
+		}
+	}
+	sort.SliceStable(d.NewPSIRTBulletins, func(i, j int) bool {
+		return d.NewPSIRTBulletins[i].GetPsirtAdvisoryId() < d.NewPSIRTBulletins[j].GetPsirtAdvisoryId()
+	})
+	sortBulletinChanges(d.UpdatedPSIRTBulletins)
+}
+
+// diffFNBulletins adds the new and updated field notice bulletins to d.
+func diffFNBulletins(d *BulkDiff, prev, next []FNBulletin) {
+	prevByID := make(map[string]FNBulletin)
+	for _, b := range prev {
+		if id := bulletinKey(b.GetFieldNoticeId()); id != "" {
+			if _, ok := prevByID[id]; !ok {
+				prevByID[id] = b
+			}
+		}
+	}
+	seen := make(map[string]bool)
+	for _, b := range next {
+		id := bulletinKey(b.GetFieldNoticeId())
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		o, ok := prevByID[id]
+		switch {
+		case !ok:
+			d.NewFNBulletins = append(d.NewFNBulletins, b)
+		case !o.GetBulletinLastUpdated().Equal(b.GetBulletinLastUpdated().Time):
+			d.UpdatedFNBulletins = append(d.UpdatedFNBulletins, BulletinChange{
+				Id:      b.GetFieldNoticeId(),
+				Title:   b.GetBulletinTitle(),
+				Changes: fieldChanges(o, b, nil),
+			})
+		}
+	}
+	sort.SliceStable(d.NewFNBulletins, func(i, j int) bool {
+		return d.NewFNBulletins[i].GetFieldNoticeId() < d.NewFNBulletins[j].GetFieldNoticeId()
+	})
+	sortBulletinChanges(d.UpdatedFNBulletins)
+}
+
+// diffTracks adds the added, removed and changed software tracks to d.
+func diffTracks(d *BulkDiff, prev, next *BulkResults) {
+	prevByID := make(map[int]TrackSummary)
+	for _, t := range prev.TrackSummaries {
+		prevByID[t.GetTrackId()] = t
+	}
+	prevRecs := smupieRecommendations(prev.TrackSmupieRecommendations)
+	nextRecs := smupieRecommendations(next.TrackSmupieRecommendations)
+	nextByID := make(map[int]bool)
+	for _, t := range next.TrackSummaries {
+		id := t.GetTrackId()
+		nextByID[id] = true
+		o, ok := prevByID[id]
+		if !ok {
+			d.AddedTracks = append(d.AddedTracks, t)
+			continue
+		}
+		c := TrackChange{TrackId: id, TrackName: t.GetTrackName(), Changes: fieldChanges(o, t, diffIgnoredTrackFields)}
+		c.AddedRecommendations = recommendationsNotIn(nextRecs[id], prevRecs[id])
+		c.RemovedRecommendations = recommendationsNotIn(prevRecs[id], nextRecs[id])
+		if len(c.Changes) > 0 || len(c.AddedRecommendations) > 0 || len(c.RemovedRecommendations) > 0 {
+			d.ChangedTracks = append(d.ChangedTracks, c)
+		}
+	}
+	for _, t := range prev.TrackSummaries {
+		if !nextByID[t.GetTrackId()] {
+			d.RemovedTracks = append(d.RemovedTracks, t)
+		}
+	}
+	sort.SliceStable(d.AddedTracks, func(i, j int) bool { return d.AddedTracks[i].GetTrackId() < d.AddedTracks[j].GetTrackId() })
+	sort.SliceStable(d.RemovedTracks, func(i, j int) bool { return d.RemovedTracks[i].GetTrackId() < d.RemovedTracks[j].GetTrackId() })
+	sort.SliceStable(d.ChangedTracks, func(i, j int) bool { return d.ChangedTracks[i].TrackId < d.ChangedTracks[j].TrackId })
+}
+
+// smupieRecommendations groups the recommendations by TrackId.
+func smupieRecommendations(recs []TrackSmupieRecommendation) map[int][]TrackSmupieRecommendation {
+	byTrack := make(map[int][]TrackSmupieRecommendation)
+	for _, r := range recs {
+		byTrack[r.GetTrackId()] = append(byTrack[r.GetTrackId()], r)
+	}
+	return byTrack
+}
+
+// recommendationsNotIn returns the recommendations in a which are not in b, matching them by
+// SwName, SwRole and TrackRecHistory.
+func recommendationsNotIn(a, b []TrackSmupieRecommendation) []TrackSmupieRecommendation {
+	key := func(r TrackSmupieRecommendation) string {
+		return r.GetSwName() + "\x00" + r.GetSwRole() + "\x00" + r.GetTrackRecHistory()
+	}
+	inB := make(map[string]bool)
+	for _, r := range b {
+		inB[key(r)] = true
+	}
+	var notIn []TrackSmupieRecommendation
+	for _, r := range a {
+		if !inB[key(r)] {
+			notIn = append(notIn, r)
+		}
+	}
+	return notIn
+}
+
+// bulletinKey returns the bulletin ID in the form used to match bulletins.
+func bulletinKey(id string) string {
+	id = strings.TrimSpace(id)
+	if IsSentinel(id) {
+		return ""
+	}
+	return id
+}
+
+// sortBulletinChanges sorts the changes by bulletin ID.
+func sortBulletinChanges(changes []BulletinChange) {
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Id < changes[j].Id })
+}
+
+// fieldChanges returns the fields of the model values prev and next, which must be of the same
+// type, whose values differ, in field order, skipping those ignored.
+func fieldChanges(prev, next interface{}, ignored map[string]bool) []FieldChange {
+	var changes []FieldChange
+	pv, nv := reflect.ValueOf(prev), reflect.ValueOf(next)
+	typ := pv.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		name := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || ignored[name] {
+			continue
+		}
+		o, n := fieldString(pv.Field(i)), fieldString(nv.Field(i))
+		if o != n {
+			changes = append(changes, FieldChange{Field: name, Old: o, New: n})
+		}
+	}
+	return changes
+}
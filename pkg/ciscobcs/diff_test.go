@@ -0,0 +1,68 @@
+package ciscobcs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffBulk(t *testing.T) {
+	prev := demoResults(t)
+	if d := DiffBulk(prev, demoResults(t)); !d.Empty() {
+		t.Errorf("got differences between identical results: %+v", d)
+	}
+
+	next := demoResults(t)
+	removed := next.Devices[0]
+	next.Devices = next.Devices[1:]
+	next.Devices[0].SwVersion = String("17.3.3")
+	next.Devices[0].InventoryTime = &DateTime{time.Now()}
+	next.Devices = append(next.Devices, Device{DeviceId: Int(1), DeviceName: String("new")})
+	next.PSIRTBulletins = append(next.PSIRTBulletins, PSIRTBulletin{PsirtAdvisoryId: String("cisco-sa-new")})
+	next.PSIRTBulletins[0].BulletinLastUpdated = &DateTime{time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)}
+	next.PSIRTBulletins[0].BulletinVersion = String("2.0")
+	next.FNBulletins[1].BulletinTitle = String("unchanged since BulletinLastUpdated is the same")
+	next.TrackSummaries[1].TrackStandardSwVersion = String("9.3(8)")
+	next.TrackSummaries[1].TrackCompliantDevices = Int(8)
+	next.TrackSmupieRecommendations = next.TrackSmupieRecommendations[1:]
+
+	d := DiffBulk(prev, next)
+	if len(d.AddedDevices) != 1 || d.AddedDevices[0].GetDeviceId() != 1 {
+		t.Errorf("got added devices %+v; want device 1", d.AddedDevices)
+	}
+	if len(d.RemovedDevices) != 1 || d.RemovedDevices[0].GetDeviceId() != removed.GetDeviceId() {
+		t.Errorf("got removed devices %+v; want device %v", d.RemovedDevices, removed.GetDeviceId())
+	}
+	if len(d.ChangedDevices) != 1 {
+		t.Fatalf("got %v changed devices; want 1", len(d.ChangedDevices))
+	}
+	want := FieldChange{Field: "swVersion", Old: prev.Devices[1].GetSwVersion(), New: "17.3.3"}
+	if got := d.ChangedDevices[0].Changes; len(got) != 1 || got[0] != want {
+		t.Errorf("got changes %+v; want %+v", got, want)
+	}
+	if len(d.NewPSIRTBulletins) != 1 || d.NewPSIRTBulletins[0].GetPsirtAdvisoryId() != "cisco-sa-new" {
+		t.Errorf("got new PSIRT bulletins %+v; want cisco-sa-new", d.NewPSIRTBulletins)
+	}
+	if len(d.UpdatedPSIRTBulletins) != 1 || len(d.UpdatedPSIRTBulletins[0].Changes) != 2 {
+		t.Errorf("got updated PSIRT bulletins %+v; want 1 with 2 changes", d.UpdatedPSIRTBulletins)
+	}
+	if len(d.NewFNBulletins) != 0 || len(d.UpdatedFNBulletins) != 0 {
+		t.Errorf("got field notice changes %+v, %+v; want none", d.NewFNBulletins, d.UpdatedFNBulletins)
+	}
+	if len(d.ChangedTracks) != 2 {
+		t.Fatalf("got %v changed tracks; want 2", len(d.ChangedTracks))
+	}
+	for _, c := range d.ChangedTracks {
+		switch c.TrackId {
+		case next.TrackSummaries[1].GetTrackId():
+			if len(c.Changes) != 1 || c.Changes[0].Field != "trackStandardSwVersion" || c.Changes[0].New != "9.3(8)" {
+				t.Errorf("got track changes %+v; want trackStandardSwVersion only", c.Changes)
+			}
+		case prev.TrackSmupieRecommendations[0].GetTrackId():
+			if len(c.RemovedRecommendations) != 1 || len(c.AddedRecommendations) != 0 {
+				t.Errorf("got recommendation changes %+v; want 1 removed", c)
+			}
+		default:
+			t.Errorf("unexpected track change %+v", c)
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package ciscobcs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultDownloadRetries is the number of times an interrupted download is resumed when
+// DownloadOptions.Retries is not set.
+const defaultDownloadRetries = 3
+
+// defaultRetryDelay is the time to wait before the first retry when DownloadOptions.RetryDelay
+// is not set, and maxRetryDelay is the most the delay is increased to.
+const (
+	defaultRetryDelay = time.Second
+	maxRetryDelay     = 30 * time.Second
+)
+
+// DownloadSummary provides details of a completed bulk download.  Bytes, Lines and SHA256
+// all relate to the uncompressed jsonlines data, regardless of the compression used for
+// the transfer or for the output.
+type DownloadSummary struct {
+	Bytes   int64
+	Lines   int
+	SHA256  string
+	Resumes int
+}
+
+// defaultProgressInterval is the time between progress reports when DownloadOptions.ProgressInterval is not set.
+const defaultProgressInterval = time.Second
+
+// DownloadProgress provides details of a bulk download in progress.  Bytes and Lines relate
+// to the uncompressed jsonlines data received so far and Rate is the average number of bytes
+// received per second.  Done is set on the final report, which is only made once the download
+// has completed and passed its sanity checks.
+type DownloadProgress struct {
+	Bytes   int64
+	Lines   int
+	Elapsed time.Duration
+	Rate    float64
+	Done    bool
+}
+
+// DownloadFile will make a bulk request and save it to the given filename.  The data is
+// written to a temporary file in the same directory which is only renamed to filename
+// once the download has completed and passed its sanity checks, so a failed download never
+// leaves a partial file under the final name.
+func (s *BulkService) DownloadFile(ctx context.Context, customerID, filename string, opts *DownloadOptions) (*DownloadSummary, error) {
+	dir, base := filepath.Split(filename)
+	if dir == "" {
+		dir = "."
+	}
+	tmp, err := ioutil.TempFile(dir, base+".*.part")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	summary, err := s.DownloadWithOptions(ctx, customerID, tmp, opts)
+	if err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), filename); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// download performs the bulk request, writing the uncompressed body to w.  Where the
+// connection drops part way through the body, the request is repeated with a Range header
+// to resume from the last byte received.  Servers that ignore the Range header have the
+// bytes already received skipped instead.  Each retry waits for an exponentially increasing
+// delay, starting at retryDelay, unless ctx is done first.
+func (c *Client) download(ctx context.Context, url string, w io.Writer, retries int, retryDelay time.Duration, progress *progressReporter) (*DownloadSummary, error) {
+	if retryDelay <= 0 {
+		retryDelay = defaultRetryDelay
+	}
+	dw := &downloadWriter{w: w, hash: sha256.New(), progress: progress}
+	size := int64(-1)
+	digest := ""
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("x-api-key", c.APIKey)
+		if dw.bytes > 0 {
+			// ranges are only meaningful against the unencoded representation
+			req.Header.Set("Accept-Encoding", "identity")
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", dw.bytes))
+		} else {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		res, err := c.HTTPClient.Do(req.WithContext(ctx))
+		if err == nil {
+			err = c.copyDownload(res, dw, &size, &digest)
+			res.Body.Close()
+			if err == nil {
+				break
+			}
+		}
+		var e Err
+		if errors.As(err, &e) || ctx.Err() != nil || attempt >= retries {
+			return nil, err
+		}
+		if err := wait(ctx, backoff(retryDelay, attempt)); err != nil {
+			return nil, err
+		}
+	}
+	if size >= 0 && dw.bytes != size {
+		return nil, fmt.Errorf("%w: received %d of %d bytes", ErrIncompleteDownload, dw.bytes, size)
+	}
+	if len(dw.tail) > 0 && !json.Valid(dw.tail) {
+		return nil, fmt.Errorf("%w: final line is truncated", ErrIncompleteDownload)
+	}
+	sum := dw.hash.Sum(nil)
+	if digest != "" && digest != base64.StdEncoding.EncodeToString(sum) {
+		return nil, ErrChecksumMismatch
+	}
+	dw.report(true)
+	return &DownloadSummary{
+		Bytes:   dw.bytes,
+		Lines:   dw.lines(),
+		SHA256:  hex.EncodeToString(sum),
+		Resumes: dw.resumes,
+	}, nil
+}
+
+// backoff returns the delay before the given retry, starting from zero, doubling the initial
+// delay for each retry up to maxRetryDelay.
+func backoff(initial time.Duration, retry int) time.Duration {
+	d := initial
+	for i := 0; i < retry && d < maxRetryDelay; i++ {
+		d *= 2
+	}
+	if d > maxRetryDelay {
+		d = maxRetryDelay
+	}
+	return d
+}
+
+// wait waits for d to pass, returning the context's error if it is done first.
+func wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// copyDownload copies a single bulk response to dw, taking account of any bytes already
+// received.  The expected size and digest of the full body are recorded where the server
+// provides them for the body without a content coding, since they are checked against the
+// decoded body.
+func (c *Client) copyDownload(res *http.Response, dw *downloadWriter, size *int64, digest *string) error {
+	if res.StatusCode == http.StatusRequestedRangeNotSatisfiable && dw.bytes > 0 {
+		// repeating the request would only ask for the same range again
+		return fmt.Errorf("%w: range from byte %d not satisfiable", ErrResumeNotSupported, dw.bytes)
+	}
+	if err := checkResponse(res); err != nil {
+		return err
+	}
+	enc := strings.ToLower(res.Header.Get("Content-Encoding"))
+	unencoded := enc == "" || enc == "identity"
+	if d := parseDigest(res.Header.Get("Digest")); d != "" && unencoded {
+		*digest = d
+	}
+	body, err := decodedBody(res)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	switch res.StatusCode {
+	case http.StatusPartialContent:
+		start, total, ok := parseContentRange(res.Header.Get("Content-Range"))
+		if !ok || start != dw.bytes {
+			return ErrResumeNotSupported
+		}
+		if total >= 0 {
+			*size = total
+		}
+		dw.resumes++
+	default:
+		if unencoded && res.ContentLength >= 0 {
+			*size = res.ContentLength
+		}
+		if dw.bytes > 0 {
+			// the server has ignored the Range header, so skip what we already have
+			if _, err := io.CopyN(ioutil.Discard, body, dw.bytes); err != nil {
+				return err
+			}
+			dw.resumes++
+		}
+	}
+	_, err = io.Copy(dw, body)
+	return err
+}
+
+// checkResponse returns the appropriate error for an unsuccessful response status code.
+func checkResponse(res *http.Response) error {
+	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	switch res.StatusCode {
+	case 400:
+		return ErrBadRequest
+	case 401:
+		return ErrUnauthorized
+	case 403:
+		return ErrForbidden
+	case 500:
+		return ErrInternalError
+	}
+	return ErrUnknown
+}
+
+// parseContentRange returns the first byte position and complete length from a Content-Range
+// header of the form "bytes 100-199/200".  The complete length is -1 when it is unknown.
+func parseContentRange(header string) (start, total int64, ok bool) {
+	header = strings.TrimPrefix(header, "bytes ")
+	parts := strings.SplitN(header, "/", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	bounds := strings.SplitN(parts[0], "-", 2)
+	start, err := strconv.ParseInt(bounds[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if parts[1] == "*" {
+		return start, -1, true
+	}
+	total, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, total, true
+}
+
+// parseDigest returns the base64 encoded SHA-256 value from a Digest header, if present.
+func parseDigest(header string) string {
+	for _, d := range strings.Split(header, ",") {
+		d = strings.TrimSpace(d)
+		if len(d) > 8 && strings.EqualFold(d[:8], "sha-256=") {
+			return d[8:]
+		}
+	}
+	return ""
+}
+
+// downloadWriter passes writes on to w while keeping track of the bytes and lines written,
+// a running checksum and the content after the final newline for the sanity checks.
+type downloadWriter struct {
+	w        io.Writer
+	hash     hash.Hash
+	bytes    int64
+	newlines int
+	tail     []byte
+	resumes  int
+	progress *progressReporter
+}
+
+func (d *downloadWriter) Write(p []byte) (int, error) {
+	n, err := d.w.Write(p)
+	p = p[:n]
+	d.hash.Write(p)
+	d.bytes += int64(n)
+	d.newlines += bytes.Count(p, []byte{'\n'})
+	if i := bytes.LastIndexByte(p, '\n'); i >= 0 {
+		d.tail = append(d.tail[:0], p[i+1:]...)
+	} else {
+		d.tail = append(d.tail, p...)
+	}
+	d.report(false)
+	return n, err
+}
+
+// report passes the current progress to the progress reporter, if there is one.
+func (d *downloadWriter) report(done bool) {
+	if d.progress != nil {
+		d.progress.report(d.bytes, d.lines(), done)
+	}
+}
+
+// lines returns the number of lines written, including any final line without a newline.
+func (d *downloadWriter) lines() int {
+	if len(d.tail) > 0 {
+		return d.newlines + 1
+	}
+	return d.newlines
+}
+
+// progressReporter rate limits calls to a DownloadOptions.Progress function.
+type progressReporter struct {
+	fn       func(DownloadProgress)
+	interval time.Duration
+	start    time.Time
+	last     time.Time
+}
+
+// newProgressReporter returns a progressReporter for fn, or nil if fn is nil.
+func newProgressReporter(fn func(DownloadProgress), interval time.Duration) *progressReporter {
+	if fn == nil {
+		return nil
+	}
+	if interval <= 0 {
+		interval = defaultProgressInterval
+	}
+	now := time.Now()
+	return &progressReporter{fn: fn, interval: interval, start: now, last: now}
+}
+
+// report calls the progress function if the interval has passed since the last call, or done is set.
+func (p *progressReporter) report(bytes int64, lines int, done bool) {
+	now := time.Now()
+	if !done && now.Sub(p.last) < p.interval {
+		return
+	}
+	p.last = now
+	elapsed := now.Sub(p.start)
+	rate := 0.0
+	if elapsed > 0 {
+		rate = float64(bytes) / elapsed.Seconds()
+	}
+	p.fn(DownloadProgress{
+		Bytes:   bytes,
+		Lines:   lines,
+		Elapsed: elapsed,
+		Rate:    rate,
+		Done:    done,
+	})
+}
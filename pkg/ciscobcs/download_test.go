@@ -0,0 +1,211 @@
+package ciscobcs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// dropHandler returns a handler which drops the connection half way through the body for the
+// first drops requests, before serving the content in full with support for Range requests.
+func dropHandler(data []byte, drops int32, ranges bool) (http.Handler, *int32) {
+	var requests int32
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= drops {
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.WriteHeader(http.StatusOK)
+			w.Write(data[:len(data)/2])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+		if !ranges {
+			r.Header.Del("Range")
+		}
+		http.ServeContent(w, r, "bcs_bulk.jsonl", time.Time{}, bytes.NewReader(data))
+	}), &requests
+}
+
+func TestDownloadFile(t *testing.T) {
+	data, err := os.ReadFile("../../demo_bcs_bulk.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	download := func(t *testing.T, h http.Handler, opts *DownloadOptions) (string, *DownloadSummary, error) {
+		ts := httptest.NewServer(h)
+		defer ts.Close()
+		c, err := NewClient("test", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.BaseURL = ts.URL
+		filename := filepath.Join(t.TempDir(), "bcs_bulk.jsonl")
+		summary, err := c.BulkService.DownloadFile(context.Background(), "1234", filename, opts)
+		return filename, summary, err
+	}
+	t.Run("resume after dropped connection", func(t *testing.T) {
+		h, requests := dropHandler(data, 1, true)
+		filename, summary, err := download(t, h, &DownloadOptions{RetryDelay: time.Millisecond})
+		if err != nil {
+			t.Fatalf("didn't expect error downloading: %v", err)
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("downloaded file does not match original")
+		}
+		if summary.Lines != 996 {
+			t.Errorf("got %v lines; want %v", summary.Lines, 996)
+		}
+		if summary.Resumes != 1 || *requests != 2 {
+			t.Errorf("got %v resumes from %v requests; want 1 from 2", summary.Resumes, *requests)
+		}
+	})
+	t.Run("resume without range support", func(t *testing.T) {
+		h, _ := dropHandler(data, 1, false)
+		filename, summary, err := download(t, h, &DownloadOptions{RetryDelay: time.Millisecond})
+		if err != nil {
+			t.Fatalf("didn't expect error downloading: %v", err)
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("downloaded file does not match original")
+		}
+		if summary.Bytes != int64(len(data)) {
+			t.Errorf("got %v bytes; want %v", summary.Bytes, len(data))
+		}
+	})
+	t.Run("progress reported", func(t *testing.T) {
+		h, _ := dropHandler(data, 0, true)
+		var reports []DownloadProgress
+		opts := &DownloadOptions{
+			Progress:         func(p DownloadProgress) { reports = append(reports, p) },
+			ProgressInterval: time.Nanosecond,
+		}
+		if _, _, err := download(t, h, opts); err != nil {
+			t.Fatalf("didn't expect error downloading: %v", err)
+		}
+		if len(reports) < 2 {
+			t.Fatalf("got %v progress reports; want at least 2", len(reports))
+		}
+		last := reports[len(reports)-1]
+		if !last.Done || last.Bytes != int64(len(data)) || last.Lines != 996 {
+			t.Errorf("got final report %+v; want done with %v bytes and 996 lines", last, len(data))
+		}
+	})
+	t.Run("no partial file on failure", func(t *testing.T) {
+		h, requests := dropHandler(data, 10, true)
+		filename, _, err := download(t, h, &DownloadOptions{Retries: 2, RetryDelay: time.Millisecond})
+		if err == nil {
+			t.Fatalf("expected error downloading")
+		}
+		if *requests != 3 {
+			t.Errorf("got %v requests; want %v", *requests, 3)
+		}
+		entries, err := os.ReadDir(filepath.Dir(filename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 0 {
+			t.Errorf("got %v files left behind; want none", len(entries))
+		}
+	})
+	t.Run("retries delayed", func(t *testing.T) {
+		h, requests := dropHandler(data, 2, true)
+		start := time.Now()
+		if _, _, err := download(t, h, &DownloadOptions{RetryDelay: 50 * time.Millisecond}); err != nil {
+			t.Fatalf("didn't expect error downloading: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < 150*time.Millisecond || *requests != 3 {
+			t.Errorf("got %v requests in %v; want 3 in at least 150ms", *requests, elapsed)
+		}
+		if d := backoff(time.Second, 10); d != maxRetryDelay {
+			t.Errorf("got %v; want %v", d, maxRetryDelay)
+		}
+	})
+	t.Run("retry wait cancelled", func(t *testing.T) {
+		h, requests := dropHandler(data, 1, true)
+		ts := httptest.NewServer(h)
+		defer ts.Close()
+		c, err := NewClient("test", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.BaseURL = ts.URL
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		time.AfterFunc(100*time.Millisecond, cancel)
+		start := time.Now()
+		_, err = c.BulkService.DownloadWithOptions(ctx, "1234", ioutil.Discard, &DownloadOptions{RetryDelay: time.Hour})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got %v; want %v", err, context.Canceled)
+		}
+		if elapsed := time.Since(start); elapsed > 10*time.Second || *requests != 1 {
+			t.Errorf("got %v requests in %v; want 1 before cancelling", *requests, elapsed)
+		}
+	})
+	t.Run("range not satisfiable", func(t *testing.T) {
+		drop, requests := dropHandler(data, 1, true)
+		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Range") != "" {
+				atomic.AddInt32(requests, 1)
+				w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			drop.ServeHTTP(w, r)
+		})
+		_, _, err := download(t, h, &DownloadOptions{RetryDelay: time.Millisecond})
+		if !errors.Is(err, ErrResumeNotSupported) || *requests != 2 {
+			t.Errorf("got %v after %v requests; want %v after 2", err, *requests, ErrResumeNotSupported)
+		}
+	})
+	t.Run("digest of encoded body", func(t *testing.T) {
+		var gz bytes.Buffer
+		zw := gzip.NewWriter(&gz)
+		zw.Write(data)
+		zw.Close()
+		encoded := sha256.Sum256(gz.Bytes())
+		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Header().Set("Digest", "sha-256="+base64.StdEncoding.EncodeToString(encoded[:]))
+			w.Write(gz.Bytes())
+		})
+		_, summary, err := download(t, h, nil)
+		if err != nil {
+			t.Fatalf("didn't expect error downloading: %v", err)
+		}
+		if summary.Bytes != int64(len(data)) {
+			t.Errorf("got %v bytes; want %v", summary.Bytes, len(data))
+		}
+	})
+	t.Run("digest mismatch", func(t *testing.T) {
+		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Digest", "sha-256="+base64.StdEncoding.EncodeToString(make([]byte, sha256.Size)))
+			w.Write(data)
+		})
+		done := false
+		opts := &DownloadOptions{Progress: func(p DownloadProgress) { done = done || p.Done }}
+		if _, _, err := download(t, h, opts); !errors.Is(err, ErrChecksumMismatch) {
+			t.Errorf("got %v; want %v", err, ErrChecksumMismatch)
+		}
+		if done {
+			t.Errorf("got a done progress report; want none for a failed download")
+		}
+	})
+}
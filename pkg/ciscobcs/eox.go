@@ -0,0 +1,252 @@
+package ciscobcs
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/darrenparkinson/bcs/pkg/swversion"
+)
+
+// EoXMilestone identifies a milestone in the end-of-life of a product, using the abbreviations
+// used by Cisco, e.g. in the currentEoxMilestone field of the hardware EoX results.
+type EoXMilestone string
+
+// The EoX milestones used in the EoX reports, in the order they usually occur.
+const (
+	MilestoneNone               EoXMilestone = ""
+	MilestoneEndOfSale          EoXMilestone = "EoSale"
+	MilestoneEndOfSWMaintenance EoXMilestone = "EoSWM"
+	MilestoneLastDateOfSupport  EoXMilestone = "LDoS"
+)
+
+// EoXMilestoneDate is an EoX milestone and the date on which it occurs.
+type EoXMilestoneDate struct {
+	Milestone EoXMilestone
+	Date      time.Time
+}
+
+// IsZero reports whether there is no milestone.
+func (m EoXMilestoneDate) IsZero() bool {
+	return m.Milestone == MilestoneNone
+}
+
+// HWEoXExposure holds the EoX milestones for a device relative to a reference date.
+type HWEoXExposure struct {
+	Device   Device
+	Bulletin HWEOXBulletin
+
+	// Current is the latest milestone on or before the reference date, if any.
+	Current EoXMilestoneDate
+
+	// Next is the earliest milestone after the reference date, if any.
+	Next EoXMilestoneDate
+}
+
+// Milestones returns the end of sale, end of SW maintenance and last date of support milestones
+// for the bulletin in date order, excluding any without a date.
+func (b *HWEOXBulletin) Milestones() []EoXMilestoneDate {
+	return milestones(map[EoXMilestone]DateTime{
+		MilestoneEndOfSale:          b.GetEoSaleDate(),
+		MilestoneEndOfSWMaintenance: b.GetEoSwMaintenanceReleasesDate(),
+		MilestoneLastDateOfSupport:  b.GetLastDateOfSupport(),
+	})
+}
+
+// Milestones returns the end of sale, end of SW maintenance and last date of support milestones
+// for the bulletin in date order, excluding any without a date.
+func (b *SWEOXBulletin) Milestones() []EoXMilestoneDate {
+	return milestones(map[EoXMilestone]DateTime{
+		MilestoneEndOfSale:          b.GetEoSaleDate(),
+		MilestoneEndOfSWMaintenance: b.GetEoSwMaintenanceReleasesDate(),
+		MilestoneLastDateOfSupport:  b.GetLastDateOfSupport(),
+	})
+}
+
+// HWEoXExposure joins each of the devices in the results to the hardware EoX bulletin for its
+// ProductId and returns the current and next EoX milestone for each relative to ref.  See the
+// HWEoXExposureReport function for details.
+func (r *BulkResults) HWEoXExposure(ref time.Time) []HWEoXExposure {
+	return HWEoXExposureReport(r.Devices, r.HWEoxBulletins, ref)
+}
+
+// HWEoXExposureReport joins each device to the hardware EoX bulletin for its ProductId, ignoring
+// case, and returns the current and next EoX milestone for each relative to ref, typically the
+// current date or the end of a quarter.  Only devices with a bulletin are included, in their
+// original order.  Where there is more than one bulletin for a product, the first is used.
+func HWEoXExposureReport(devices []Device, bulletins []HWEOXBulletin, ref time.Time) []HWEoXExposure {
+	byProduct := hwEoXBulletinIndex(bulletins)
+	var report []HWEoXExposure
+	for _, d := range devices {
+		i, ok := byProduct[productKey(d.GetProductId())]
+		if !ok {
+			continue
+		}
+		e := HWEoXExposure{Device: d, Bulletin: bulletins[i]}
+		e.Current, e.Next = milestonesAt(bulletins[i].Milestones(), ref)
+		report = append(report, e)
+	}
+	return report
+}
+
+// hwEoXBulletinIndex returns the index of the first bulletin for each product, keyed by productKey.
+func hwEoXBulletinIndex(bulletins []HWEOXBulletin) map[string]int {
+	byProduct := make(map[string]int)
+	for i := range bulletins {
+		pid := productKey(bulletins[i].GetProductId())
+		if _, ok := byProduct[pid]; !ok && pid != "" {
+			byProduct[pid] = i
+		}
+	}
+	return byProduct
+}
+
+// productKey returns the product ID in the form used to join records.
+func productKey(pid string) string {
+	pid = strings.ToUpper(strings.TrimSpace(pid))
+	if IsSentinel(pid) {
+		return ""
+	}
+	return pid
+}
+
+// milestones returns the milestones with a date, in date order.
+func milestones(dates map[EoXMilestone]DateTime) []EoXMilestoneDate {
+	var ms []EoXMilestoneDate
+	for m, d := range dates {
+		if !d.IsZero() {
+			ms = append(ms, EoXMilestoneDate{Milestone: m, Date: d.Time})
+		}
+	}
+	order := map[EoXMilestone]int{MilestoneEndOfSale: 1, MilestoneEndOfSWMaintenance: 2, MilestoneLastDateOfSupport: 3}
+	sort.Slice(ms, func(i, j int) bool {
+		if !ms[i].Date.Equal(ms[j].Date) {
+			return ms[i].Date.Before(ms[j].Date)
+		}
+		return order[ms[i].Milestone] < order[ms[j].Milestone]
+	})
+	return ms
+}
+
+// milestonesAt returns the latest milestone on or before ref and the earliest after it.
+func milestonesAt(ms []EoXMilestoneDate, ref time.Time) (current, next EoXMilestoneDate) {
+	for _, m := range ms {
+		if m.Date.After(ref) {
+			return current, m
+		}
+		current = m
+	}
+	return current, next
+}
+
+// SWEoXExposure holds the software EoX bulletins for a device which is running software past its
+// last date of support or end of vulnerability/security support.
+type SWEoXExposure struct {
+	Device Device
+
+	// Bulletins holds the bulletins matching the software on the device.  There may be more than
+	// one where the bulletins differ only by platform, which is not available in the results.
+	Bulletins []SWEOXBulletin
+
+	// LastDateOfSupport is the earliest last date of support of the bulletins, if any.
+	LastDateOfSupport time.Time
+
+	// EoSecurityVulSupportDate is the earliest end of vulnerability/security support date of the
+	// bulletins, if any.
+	EoSecurityVulSupportDate time.Time
+
+	// PastLastDateOfSupport reports whether the reference date is on or after LastDateOfSupport.
+	PastLastDateOfSupport bool
+
+	// PastSecurityVulSupport reports whether the reference date is on or after EoSecurityVulSupportDate.
+	PastSecurityVulSupport bool
+}
+
+// Ambiguous reports whether more than one bulletin matched the software on the device, in which
+// case the earliest dates of the bulletins are used.
+func (e SWEoXExposure) Ambiguous() bool {
+	return len(e.Bulletins) > 1
+}
+
+// SWEoXExposure returns the devices in the results which are running software past its last date
+// of support or end of vulnerability/security support at ref.  See the SWEoXExposureReport function
+// for details.
+func (r *BulkResults) SWEoXExposure(ref time.Time) []SWEoXExposure {
+	return SWEoXExposureReport(r.Devices, r.SWEoxBulletins, ref)
+}
+
+// SWEoXExposureReport matches the software on each device to the software EoX bulletins, using
+// MatchSWEoXBulletins, and returns those devices which are running software past its last date of
+// support or end of vulnerability/security support at ref, in their original order.
+func SWEoXExposureReport(devices []Device, bulletins []SWEOXBulletin, ref time.Time) []SWEoXExposure {
+	var report []SWEoXExposure
+	for i := range devices {
+		matches := MatchSWEoXBulletins(&devices[i], bulletins)
+		if len(matches) == 0 {
+			continue
+		}
+		e := SWEoXExposure{Device: devices[i], Bulletins: matches}
+		for _, b := range matches {
+			e.LastDateOfSupport = earliest(e.LastDateOfSupport, b.GetLastDateOfSupport().Time)
+			e.EoSecurityVulSupportDate = earliest(e.EoSecurityVulSupportDate, b.GetEoSecurityVulSupportDate().Time)
+		}
+		e.PastLastDateOfSupport = !e.LastDateOfSupport.IsZero() && !ref.Before(e.LastDateOfSupport)
+		e.PastSecurityVulSupport = !e.EoSecurityVulSupportDate.IsZero() && !ref.Before(e.EoSecurityVulSupportDate)
+		if e.PastLastDateOfSupport || e.PastSecurityVulSupport {
+			report = append(report, e)
+		}
+	}
+	return report
+}
+
+// MatchSWEoXBulletins returns the software EoX bulletins which apply to the software running on
+// the device.  A bulletin applies where it has the same SwType as the device, the SwVersion of the
+// device starts with its SwMajorVersion, e.g. 16.12 for 16.12.3s, and its SwMaintenanceVersion and
+// SwTrain, where provided, match those of the device, e.g. 55 and SE for 12.2(55)SE2.  Versions are
+// parsed using the swversion package.
+//
+// Only the most specific of the matching bulletins are returned, so a bulletin for 6.0(2)U1 is
+// preferred to one for 6.0.  Devices whose version cannot be parsed, e.g. "Not Found", match none.
+func MatchSWEoXBulletins(d *Device, bulletins []SWEOXBulletin) []SWEOXBulletin {
+	v, err := swversion.Parse(d.GetSwType(), d.GetSwVersion())
+	if err != nil {
+		return nil
+	}
+	var matches []SWEOXBulletin
+	best := 0
+	for _, b := range bulletins {
+		if !swversion.SameType(d.GetSwType(), b.GetSwType()) || !v.HasPrefix(b.GetSwMajorVersion()) {
+			continue
+		}
+		specificity := len(strings.FieldsFunc(b.GetSwMajorVersion(), func(r rune) bool {
+			return r == '.' || r == '(' || r == ')'
+		}))
+		if m := strings.TrimSpace(b.GetSwMaintenanceVersion()); m != "" {
+			if !strings.EqualFold(m, v.Maintenance) {
+				continue
+			}
+			specificity++
+		}
+		if t := strings.TrimSpace(b.GetSwTrain()); t != "" {
+			if !strings.EqualFold(t, v.Train) {
+				continue
+			}
+			specificity++
+		}
+		switch {
+		case specificity > best:
+			matches, best = []SWEOXBulletin{b}, specificity
+		case specificity == best:
+			matches = append(matches, b)
+		}
+	}
+	return matches
+}
+
+// earliest returns the earlier of the two times, ignoring zero times.
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
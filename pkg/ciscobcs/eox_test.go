@@ -0,0 +1,171 @@
+package ciscobcs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestHWEoXExposure(t *testing.T) {
+	date := func(s string) time.Time {
+		tm, err := time.Parse(DateFormat, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	bulletins := []HWEOXBulletin{
+		{
+			ProductId:                   String("WS-C3650-24PD-E"),
+			EoSaleDate:                  &DateTime{date("2021-10-31")},
+			EoSwMaintenanceReleasesDate: &DateTime{date("2022-10-31")},
+			LastDateOfSupport:           &DateTime{date("2026-10-31")},
+		},
+	}
+	devices := []Device{
+		{DeviceId: Int(1), ProductId: String("ws-c3650-24pd-e")},
+		{DeviceId: Int(2), ProductId: String("C9300-24P")},
+		{DeviceId: Int(3), ProductId: String("Missing")},
+	}
+	tests := []struct {
+		ref           string
+		current, next EoXMilestone
+	}{
+		{"2021-01-01", MilestoneNone, MilestoneEndOfSale},
+		{"2021-10-31", MilestoneEndOfSale, MilestoneEndOfSWMaintenance},
+		{"2023-06-30", MilestoneEndOfSWMaintenance, MilestoneLastDateOfSupport},
+		{"2027-01-01", MilestoneLastDateOfSupport, MilestoneNone},
+	}
+	for _, tt := range tests {
+		report := HWEoXExposureReport(devices, bulletins, date(tt.ref))
+		if len(report) != 1 || report[0].Device.GetDeviceId() != 1 {
+			t.Fatalf("%s: got %v devices; want device 1 only", tt.ref, len(report))
+		}
+		if got := report[0]; got.Current.Milestone != tt.current || got.Next.Milestone != tt.next {
+			t.Errorf("%s: got %q, %q; want %q, %q", tt.ref, got.Current.Milestone, got.Next.Milestone, tt.current, tt.next)
+		}
+	}
+	if got := HWEoXExposureReport(devices, bulletins, date("2021-01-01"))[0].Next.Date; !got.Equal(date("2021-10-31")) {
+		t.Errorf("got next date %v; want 2021-10-31", got)
+	}
+
+	t.Run("demo", func(t *testing.T) {
+		report := demoResults(t).HWEoXExposure(date("2021-09-01"))
+		if len(report) != 65 {
+			t.Errorf("got %v devices; want 65", len(report))
+		}
+	})
+}
+
+func TestMatchSWEoXBulletins(t *testing.T) {
+	bulletins := []SWEOXBulletin{
+		{SwEoxId: Int(1), SwType: String("IOS"), SwMajorVersion: String("12.2")},
+		{SwEoxId: Int(2), SwType: String("IOS"), SwMajorVersion: String("12.2"), SwMaintenanceVersion: String("55"), SwTrain: String("SE")},
+		{SwEoxId: Int(3), SwType: String("NX-OS"), SwMajorVersion: String("5.2"), SwTrain: String("N1")},
+		{SwEoxId: Int(4), SwType: String("NX-OS"), SwMajorVersion: String("5.2"), SwTrain: String("N1")},
+		{SwEoxId: Int(5), SwType: String("IOS-XE"), SwMajorVersion: String("16.12")},
+		{SwEoxId: Int(6), SwType: String("IOS XR"), SwMajorVersion: String("6.1")},
+		{SwEoxId: Int(7), SwType: String("IOS XR"), SwMajorVersion: String("6.1.2")},
+	}
+	tests := []struct {
+		swType, swVersion string
+		want              []int
+	}{
+		{"IOS", "12.2(55)SE2", []int{2}},
+		{"IOS", "12.2(58)SE2", []int{1}},
+		{"IOS", "12.22(1)", nil},
+		{"NX-OS", "5.2(1)N1(9)", []int{3, 4}},
+		{"IOS-XE", "16.12.3s", []int{5}},
+		{"IOS", "16.12.3s", nil},
+		{"IOS-XE", "Not Found", nil},
+		{"IOS XR", "6.1.22", []int{7}},
+		{"IOS XR", "6.1.22.23", []int{7}},
+		{"IOS-XR", "6.1.2", []int{7}},
+		{"IOS XR", "6.1.3", []int{6}},
+	}
+	for _, tt := range tests {
+		d := &Device{SwType: String(tt.swType), SwVersion: String(tt.swVersion)}
+		var got []int
+		for _, b := range MatchSWEoXBulletins(d, bulletins) {
+			got = append(got, b.GetSwEoxId())
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%s %s: got %v; want %v", tt.swType, tt.swVersion, got, tt.want)
+		}
+	}
+}
+
+func TestSWEoXExposure(t *testing.T) {
+	date := func(s string) time.Time {
+		tm, err := time.Parse(DateFormat, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	bulletins := []SWEOXBulletin{
+		{SwType: String("IOS-XE"), SwMajorVersion: String("16.9"), LastDateOfSupport: &DateTime{date("2023-07-31")}, EoSecurityVulSupportDate: &DateTime{date("2022-07-31")}},
+		{SwType: String("IOS-XE"), SwMajorVersion: String("16.9"), LastDateOfSupport: &DateTime{date("2024-07-31")}},
+	}
+	devices := []Device{
+		{DeviceId: Int(1), SwType: String("IOS-XE"), SwVersion: String("16.9.4")},
+		{DeviceId: Int(2), SwType: String("IOS-XE"), SwVersion: String("17.3.1")},
+	}
+	if got := SWEoXExposureReport(devices, bulletins, date("2022-07-30")); len(got) != 0 {
+		t.Errorf("got %v devices before end of security support; want none", len(got))
+	}
+	got := SWEoXExposureReport(devices, bulletins, date("2022-07-31"))
+	if len(got) != 1 || got[0].Device.GetDeviceId() != 1 || !got[0].Ambiguous() {
+		t.Fatalf("got %+v; want ambiguous device 1", got)
+	}
+	if !got[0].PastSecurityVulSupport || got[0].PastLastDateOfSupport || !got[0].LastDateOfSupport.Equal(date("2023-07-31")) {
+		t.Errorf("got %+v; want past security support only, with the earliest last date of support", got[0])
+	}
+
+	t.Run("demo", func(t *testing.T) {
+		results := demoResults(t)
+		if len(results.SWEoxBulletins) != 34 {
+			t.Fatalf("got %v bulletins; want 34", len(results.SWEoxBulletins))
+		}
+		cisco := make(map[int]int)
+		for id, extra := range demoDeviceExtras(t) {
+			if extra.SwEox != nil && extra.SwEox.SwEoxId != 0 {
+				cisco[id] = extra.SwEox.SwEoxId
+			}
+		}
+		if len(cisco) == 0 {
+			t.Fatal("no devices with a software EoX bulletin in the bulk file")
+		}
+		for i := range results.Devices {
+			d := &results.Devices[i]
+			want, ok := cisco[d.GetDeviceId()]
+			if !ok {
+				continue
+			}
+			// only the most specific bulletins are matched, so any others are for other platforms
+			// with the same versions, e.g. the NX-OS 5.2 bulletins for the Nexus 5000 and 7000
+			found, tied := false, true
+			var got []int
+			matches := MatchSWEoXBulletins(d, results.SWEoxBulletins)
+			for _, b := range matches {
+				found = found || b.GetSwEoxId() == want
+				tied = tied && b.GetSwMajorVersion() == matches[0].GetSwMajorVersion() &&
+					b.GetSwMaintenanceVersion() == matches[0].GetSwMaintenanceVersion() &&
+					b.GetSwTrain() == matches[0].GetSwTrain()
+				got = append(got, b.GetSwEoxId())
+			}
+			if !found || !tied {
+				t.Errorf("device %v %s %s: got bulletins %v; want %v", d.GetDeviceId(), d.GetSwType(), d.GetSwVersion(), got, want)
+			}
+		}
+		report := results.SWEoXExposure(date("2021-09-01"))
+		if len(report) == 0 {
+			t.Error("got no devices past software EoX")
+		}
+		for _, e := range report {
+			if !e.PastLastDateOfSupport && !e.PastSecurityVulSupport {
+				t.Errorf("device %v is not past either date", e.Device.GetDeviceId())
+			}
+		}
+	})
+}
@@ -16,4 +16,17 @@ const (
 	ErrForbidden     = Err("ciscobcs: forbidden")
 	ErrInternalError = Err("ciscobcs: internal error")
 	ErrUnknown       = Err("ciscobcs: unexpected error occurred")
+
+	ErrUnsupportedCompression = Err("ciscobcs: unsupported compression")
+	ErrResumeNotSupported     = Err("ciscobcs: unable to resume download")
+	ErrIncompleteDownload     = Err("ciscobcs: incomplete download")
+	ErrChecksumMismatch       = Err("ciscobcs: download checksum mismatch")
+	ErrUnrecognisedBulkType   = Err("ciscobcs: unrecognised bulk type")
+
+	ErrInvalidFilter      = Err("ciscobcs: invalid filter")
+	ErrUnknownFilterField = Err("ciscobcs: unknown filter field")
+	ErrInvalidMask        = Err("ciscobcs: invalid mask")
+	ErrUnknownMaskField   = Err("ciscobcs: unknown mask field")
+	ErrUnknownGroupField  = Err("ciscobcs: unknown group field")
+	ErrInvalidTagRules    = Err("ciscobcs: invalid tag rules")
 )
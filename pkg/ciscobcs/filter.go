@@ -0,0 +1,265 @@
+package ciscobcs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Filter selects records by the values of their fields, using the same JSON syntax as the filter
+// parameter of the API, e.g. {"productFamily":"Cisco Catalyst 3750 Series Switches"}, so that the
+// same filters can be used with bulk data offline.
+//
+// Field names are the json names of the model fields, ignoring case, so productfamily is the same
+// as productFamily.  Values are compared with the value of the field as it appears in the JSON,
+// ignoring case, and may include % as a wildcard matching any number of characters, e.g.
+// {"deviceName":"%-rtr-%"}.  A record matches when every field in the filter matches.  As an
+// extension to the API syntax, an array of values matches any of the values, and null matches a
+// missing value or one of the Sentinels.
+type Filter struct {
+	terms []filterTerm
+	raw   string
+}
+
+// filterTerm holds the values allowed for a single field.
+type filterTerm struct {
+	field  string
+	values []filterValue
+}
+
+// filterValue is a single value allowed for a field.  A nil pattern matches a missing value.
+type filterValue struct {
+	pattern *regexp.Regexp
+}
+
+// ParseFilter parses a filter in the JSON syntax used by the API.  An empty filter matches every
+// record.
+func ParseFilter(s string) (*Filter, error) {
+	f := &Filter{raw: strings.TrimSpace(s)}
+	if f.raw == "" {
+		return f, nil
+	}
+	var fields map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(f.raw))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+	for name, v := range fields {
+		term := filterTerm{field: name}
+		values, ok := v.([]interface{})
+		if !ok {
+			values = []interface{}{v}
+		}
+		for _, v := range values {
+			value, err := parseFilterValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", ErrInvalidFilter, name, err)
+			}
+			term.values = append(term.values, value)
+		}
+		if len(term.values) == 0 {
+			return nil, fmt.Errorf("%w: %s: no values", ErrInvalidFilter, name)
+		}
+		f.terms = append(f.terms, term)
+	}
+	sort.Slice(f.terms, func(i, j int) bool { return f.terms[i].field < f.terms[j].field })
+	return f, nil
+}
+
+// parseFilterValue returns the value for a string, number, boolean or null in a filter.
+func parseFilterValue(v interface{}) (filterValue, error) {
+	var s string
+	switch v := v.(type) {
+	case nil:
+		return filterValue{}, nil
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case bool:
+		s = fmt.Sprint(v)
+	default:
+		return filterValue{}, fmt.Errorf("unsupported value %v", v)
+	}
+	parts := strings.Split(s, "%")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	re, err := regexp.Compile(`(?is)^` + strings.Join(parts, ".*") + `$`)
+	if err != nil {
+		return filterValue{}, err
+	}
+	return filterValue{pattern: re}, nil
+}
+
+// String returns the filter as it was provided.
+func (f *Filter) String() string {
+	return f.raw
+}
+
+// Fields returns the names of the fields in the filter, as they were provided.
+func (f *Filter) Fields() []string {
+	var fields []string
+	for _, t := range f.terms {
+		fields = append(fields, t.field)
+	}
+	return fields
+}
+
+// Validate returns an error wrapping ErrUnknownFilterField if any of the fields in the filter
+// are not fields of v, which must be one of the model types or a pointer to one.
+func (f *Filter) Validate(v interface{}) error {
+	_, err := f.matcher(reflect.TypeOf(v))
+	return err
+}
+
+// Match reports whether v, which must be one of the model types or a pointer to one, matches
+// the filter.  Records never match a filter with a field they do not have.
+func (f *Filter) Match(v interface{}) bool {
+	m, err := f.matcher(reflect.TypeOf(v))
+	return err == nil && m(reflect.Indirect(reflect.ValueOf(v)))
+}
+
+// matcher resolves the fields in the filter for the struct type t, or a pointer to it, and
+// returns a function reporting whether a value of that type matches.
+func (f *Filter) matcher(t reflect.Type) (func(reflect.Value) bool, error) {
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %v", ErrUnrecognisedBulkType, t)
+	}
+	fields := jsonFields(t)
+	indexes := make([]int, len(f.terms))
+	for i, term := range f.terms {
+		index, ok := fields[strings.ToLower(term.field)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFilterField, term.field)
+		}
+		indexes[i] = index
+	}
+	return func(v reflect.Value) bool {
+		for i, term := range f.terms {
+			if !term.match(v.Field(indexes[i])) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// match reports whether the field value matches any of the values of the term.
+func (t filterTerm) match(v reflect.Value) bool {
+	s, ok := jsonFieldString(v)
+	for _, value := range t.values {
+		if value.pattern == nil {
+			if !ok || IsSentinel(s) {
+				return true
+			}
+			continue
+		}
+		if ok && value.pattern.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonFields returns the index of each of the fields of the struct type t, keyed by the lower
+// case json name of the field.
+func jsonFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonName(t.Field(i)); name != "" {
+			fields[strings.ToLower(name)] = i
+		}
+	}
+	return fields
+}
+
+// jsonFieldString returns the value of the model field as it appears in the JSON, without the
+// quotes for a string.  The boolean is false where the field is nil.
+func jsonFieldString(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return "", false
+	}
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return "", false
+	}
+	var s string
+	if bytes.HasPrefix(b, []byte(`"`)) && json.Unmarshal(b, &s) == nil {
+		return s, true
+	}
+	return string(b), true
+}
+
+// Query returns the records of the given bulk type, e.g. device or psirt_bulletin, which match
+// the filter, as values of the corresponding model type.  An error is returned where the type
+// is not recognised or the filter has a field which the type does not.
+func (r *BulkResults) Query(bulkType string, f *Filter) ([]interface{}, error) {
+	records, err := r.records(bulkType)
+	if err != nil {
+		return nil, err
+	}
+	match, err := f.matcher(records.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+	var matches []interface{}
+	for i := 0; i < records.Len(); i++ {
+		if v := records.Index(i); match(v) {
+			matches = append(matches, v.Interface())
+		}
+	}
+	return matches, nil
+}
+
+// BulkRecords returns the values, e.g. those returned by Query, as records of their bulk type
+// along with, for devices, the advisories and field notices held for them in the results, so
+// that BulkWriter writes them as they appear in the bulk data.  As in the bulk data, these are
+// only nested in the first record for each device.
+func (r *BulkResults) BulkRecords(values []interface{}) []BulkRecord {
+	advisories, notices := r.deviceAlerts()
+	recs := make([]BulkRecord, len(values))
+	for i, v := range values {
+		recs[i].Type, _ = bulkTypeOf(v)
+		recs[i].Value = v
+		if d, ok := v.(Device); ok {
+			id := d.GetDeviceId()
+			recs[i].Advisories, recs[i].FieldNotices = advisories[id], notices[id]
+			delete(advisories, id)
+			delete(notices, id)
+		}
+	}
+	return recs
+}
+
+// records returns the slice of records of the given bulk type.
+func (r *BulkResults) records(bulkType string) (reflect.Value, error) {
+	var records reflect.Value
+	switch bulkType {
+	case "device":
+		records = reflect.ValueOf(r.Devices)
+	case "track_summary":
+		records = reflect.ValueOf(r.TrackSummaries)
+	case "track_smupie_recommendation":
+		records = reflect.ValueOf(r.TrackSmupieRecommendations)
+	case "sw_eox_bulletin":
+		records = reflect.ValueOf(r.SWEoxBulletins)
+	case "hw_eox_bulletin":
+		records = reflect.ValueOf(r.HWEoxBulletins)
+	case "fn_bulletin":
+		records = reflect.ValueOf(r.FNBulletins)
+	case "psirt_bulletin":
+		records = reflect.ValueOf(r.PSIRTBulletins)
+	default:
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrUnrecognisedBulkType, bulkType)
+	}
+	return records, nil
+}
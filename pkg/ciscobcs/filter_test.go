@@ -0,0 +1,127 @@
+package ciscobcs
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	d := Device{
+		DeviceId:      Int(24932812),
+		DeviceName:    String("lon-rtr-01"),
+		ProductFamily: String("Cisco Catalyst 3750 Series Switches"),
+		SwVersion:     String("Not Found"),
+	}
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{``, true},
+		{`{"productFamily":"Cisco Catalyst 3750 Series Switches"}`, true},
+		{`{"productfamily":"cisco catalyst 3750 series switches"}`, true},
+		{`{"productFamily":"Cisco Catalyst 3750"}`, false},
+		{`{"deviceName":"%-rtr-%"}`, true},
+		{`{"deviceName":"%-sw-%"}`, false},
+		{`{"deviceName":"lon%", "deviceId":24932812}`, true},
+		{`{"deviceName":"lon%", "deviceId":1}`, false},
+		{`{"deviceName":["par%","lon%"]}`, true},
+		{`{"swVersion":null, "productId":null}`, true},
+		{`{"deviceName":null}`, false},
+		{`{"deviceName":"lon.rtr.01"}`, false},
+	}
+	for _, tt := range tests {
+		f, err := ParseFilter(tt.filter)
+		if err != nil {
+			t.Errorf("%s: %v", tt.filter, err)
+			continue
+		}
+		if got := f.Match(&d); got != tt.want {
+			t.Errorf("%s: got %v; want %v", tt.filter, got, tt.want)
+		}
+	}
+
+	for _, s := range []string{`{"deviceName":`, `["a"]`, `{"deviceName":{"a":"b"}}`, `{"deviceName":[]}`} {
+		if _, err := ParseFilter(s); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("%s: got %v; want %v", s, err, ErrInvalidFilter)
+		}
+	}
+	f, err := ParseFilter(`{"fieldNoticeId":"63743"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Validate(d); !errors.Is(err, ErrUnknownFilterField) {
+		t.Errorf("got %v; want %v", err, ErrUnknownFilterField)
+	}
+	if f.Match(d) {
+		t.Error("expected no match for a field the record does not have")
+	}
+
+	t.Run("query", func(t *testing.T) {
+		results := demoResults(t)
+		f, err := ParseFilter(`{"productFamily":"Cisco ASR 9000 Series%"}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		devices, err := results.Query("device", f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := 0
+		for _, d := range results.Devices {
+			if strings.HasPrefix(d.GetProductFamily(), "Cisco ASR 9000 Series") {
+				want++
+			}
+		}
+		if len(devices) != want || want == 0 {
+			t.Errorf("got %v devices; want %v", len(devices), want)
+		}
+		if _, ok := devices[0].(Device); !ok {
+			t.Errorf("got %T; want Device", devices[0])
+		}
+		var buf bytes.Buffer
+		bw := NewBulkWriter(&buf)
+		for _, rec := range results.BulkRecords(append(devices, devices[0])) {
+			if err := bw.Write(rec); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := bw.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		written, err := scanBulk(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := make(map[int]bool)
+		for _, d := range devices {
+			d := d.(Device)
+			ids[d.GetDeviceId()] = true
+		}
+		advisories, notices := 0, 0
+		for _, a := range results.SecurityAdvisories {
+			if ids[a.GetDeviceId()] {
+				advisories++
+			}
+		}
+		for _, n := range results.FieldNotices {
+			if ids[n.GetDeviceId()] {
+				notices++
+			}
+		}
+		if len(written.SecurityAdvisories) != advisories || len(written.FieldNotices) != notices || advisories == 0 {
+			t.Errorf("got %v advisories and %v field notices written; want %v and %v", len(written.SecurityAdvisories), len(written.FieldNotices), advisories, notices)
+		}
+		f, _ = ParseFilter(`{"sir":"Critical","bulletinFirstPublished":"2009%"}`)
+		if bulletins, err := results.Query("psirt_bulletin", f); err != nil || len(bulletins) == 0 {
+			t.Errorf("got %v critical bulletins from 2009, %v; want some", len(bulletins), err)
+		}
+		if _, err := results.Query("device", f); !errors.Is(err, ErrUnknownFilterField) {
+			t.Errorf("got %v; want %v", err, ErrUnknownFilterField)
+		}
+		if _, err := results.Query("unknown", f); !errors.Is(err, ErrUnrecognisedBulkType) {
+			t.Errorf("got %v; want %v", err, ErrUnrecognisedBulkType)
+		}
+	})
+}
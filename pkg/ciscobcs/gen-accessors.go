@@ -0,0 +1,242 @@
+//go:build ignore
+// +build ignore
+
+// gen-accessors generates nil-safe GetX accessor methods for the pointer fields of each of the
+// model structs in the ciscobcs package, i.e. those declared in models*.go, and of the request
+// bodies declared in services_gen.go.
+//
+// It is meant to be used by go generate from the ciscobcs package directory:
+//
+//	go run gen-accessors.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const fileSuffix = "-accessors.go"
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	// zeroValues holds the zero values for the basic types used in the models.
+	zeroValues = map[string]string{
+		"bool":    "false",
+		"float32": "0",
+		"float64": "0",
+		"int":     "0",
+		"int64":   "0",
+		"string":  `""`,
+	}
+
+	// valueTypes holds the struct types which are returned by value rather than as a pointer.
+	valueTypes = map[string]bool{
+		"Date":      true,
+		"DateTime":  true,
+		"Timestamp": true,
+	}
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	files, err := filepath.Glob("models*.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	files = append(files, "services_gen.go")
+	t := &templateData{
+		filename: "ciscobcs" + fileSuffix,
+		Package:  "ciscobcs",
+		Imports:  map[string]string{},
+	}
+	for _, filename := range files {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		t.processAST(f)
+	}
+	if err := t.dump(); err != nil {
+		log.Fatal(err)
+	}
+	logf("Done.")
+}
+
+func (t *templateData) processAST(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || !ast.IsExported(ts.Name.Name) {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				se, ok := field.Type.(*ast.StarExpr)
+				if !ok || len(field.Names) == 0 {
+					continue
+				}
+				for _, name := range field.Names {
+					if !ast.IsExported(name.Name) {
+						continue
+					}
+					switch x := se.X.(type) {
+					case *ast.Ident:
+						t.addIdent(x, ts.Name.String(), name.String())
+					case *ast.SelectorExpr:
+						t.addSelectorExpr(x, ts.Name.String(), name.String())
+					default:
+						logf("processAST: type %q, field %q, unknown %T: %+v", ts.Name, name, x, x)
+					}
+				}
+			}
+		}
+	}
+}
+
+func (t *templateData) addIdent(x *ast.Ident, receiverType, fieldName string) {
+	if zeroValue, ok := zeroValues[x.String()]; ok {
+		t.Getters = append(t.Getters, newGetter(receiverType, fieldName, x.String(), zeroValue, true))
+		return
+	}
+	if valueTypes[x.String()] {
+		t.Getters = append(t.Getters, newGetter(receiverType, fieldName, x.String(), x.String()+"{}", true))
+		return
+	}
+	t.Getters = append(t.Getters, newGetter(receiverType, fieldName, "*"+x.String(), "nil", false))
+}
+
+func (t *templateData) addSelectorExpr(x *ast.SelectorExpr, receiverType, fieldName string) {
+	var xX string
+	if xx, ok := x.X.(*ast.Ident); ok {
+		xX = xx.String()
+	}
+	fieldType := fmt.Sprintf("%v.%v", xX, x.Sel.Name)
+	if xX == "time" && x.Sel.Name == "Time" {
+		t.Getters = append(t.Getters, newGetter(receiverType, fieldName, fieldType, "time.Time{}", true))
+		t.Imports["time"] = "time"
+		return
+	}
+	t.Getters = append(t.Getters, newGetter(receiverType, fieldName, "*"+fieldType, "nil", false))
+}
+
+func (t *templateData) dump() error {
+	if len(t.Getters) == 0 {
+		logf("No getters for %v; skipping.", t.filename)
+		return nil
+	}
+
+	// Sort getters by ReceiverType.FieldName.
+	sort.Sort(byName(t.Getters))
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", t.filename)
+	return ioutil.WriteFile(t.filename, clean, 0644)
+}
+
+func newGetter(receiverType, fieldName, fieldType, zeroValue string, namedStruct bool) *getter {
+	return &getter{
+		sortVal:      strings.ToLower(receiverType) + "." + strings.ToLower(fieldName),
+		ReceiverVar:  strings.ToLower(receiverType[:1]),
+		ReceiverType: receiverType,
+		FieldName:    fieldName,
+		FieldType:    fieldType,
+		ZeroValue:    zeroValue,
+		NamedStruct:  namedStruct,
+	}
+}
+
+type templateData struct {
+	filename string
+	Package  string
+	Imports  map[string]string
+	Getters  []*getter
+}
+
+type getter struct {
+	sortVal      string // Lower-case version of "ReceiverType.FieldName".
+	ReceiverVar  string // The one-letter variable name to match the ReceiverType.
+	ReceiverType string
+	FieldName    string
+	FieldType    string
+	ZeroValue    string
+	NamedStruct  bool // Getter for named struct or basic type, which is dereferenced.
+}
+
+type byName []*getter
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Less(i, j int) bool { return b[i].sortVal < b[j].sortVal }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+const source = `// Code generated by gen-accessors; DO NOT EDIT.
+
+// Instead, please run "go generate ./..." from the root of the repository.
+
+package {{.Package}}
+{{with .Imports}}
+import (
+  {{- range . -}}
+  "{{.}}"
+  {{end -}}
+)
+{{end}}
+{{range .Getters}}
+{{if .NamedStruct}}
+// Get{{.FieldName}} returns the {{.FieldName}} field if it's non-nil, zero value otherwise.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Get{{.FieldName}}() {{.FieldType}} {
+  if {{.ReceiverVar}} == nil || {{.ReceiverVar}}.{{.FieldName}} == nil {
+    return {{.ZeroValue}}
+  }
+  return *{{.ReceiverVar}}.{{.FieldName}}
+}
+{{else}}
+// Get{{.FieldName}} returns the {{.FieldName}} field.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Get{{.FieldName}}() {{.FieldType}} {
+  if {{.ReceiverVar}} == nil {
+    return nil
+  }
+  return {{.ReceiverVar}}.{{.FieldName}}
+}
+{{end}}
+{{end}}
+`
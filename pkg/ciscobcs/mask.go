@@ -0,0 +1,249 @@
+package ciscobcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Mask selects fields of the results, using the same syntax as the mask parameter of the API, i.e.
+// comma separated field names with nested fields between brackets, e.g.
+// items{deviceName,productId},page,pages,total.  It is used as the Mask of the list options, and
+// can be applied to records client-side with Project, or when writing them with a CSVWriter.
+type Mask []MaskField
+
+// MaskField is a single field of a mask, along with any nested fields.
+type MaskField struct {
+	Name   string
+	Fields Mask
+}
+
+// NewMask returns a mask selecting the given fields.
+func NewMask(fields ...string) Mask {
+	m := make(Mask, len(fields))
+	for i, f := range fields {
+		m[i] = MaskField{Name: f}
+	}
+	return m
+}
+
+// ItemsMask returns a mask for the list methods, selecting the given fields of the items along
+// with the page details, e.g. items{deviceName,productId},page,pages,total.  Without any fields
+// it returns a nil mask, which selects every field.
+func ItemsMask(fields ...string) Mask {
+	if len(fields) == 0 {
+		return nil
+	}
+	return Mask{
+		{Name: "items", Fields: NewMask(fields...)},
+		{Name: "page"},
+		{Name: "pages"},
+		{Name: "total"},
+	}
+}
+
+// ParseMask parses a mask in the syntax used by the API, e.g. items{deviceName,productId},page.
+// An empty mask selects every field.
+func ParseMask(s string) (Mask, error) {
+	p := maskParser{s: s}
+	m, err := p.parseList()
+	if err == nil && p.pos < len(p.s) {
+		err = fmt.Errorf("unexpected %q at %d", p.s[p.pos], p.pos)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMask, err)
+	}
+	return m, nil
+}
+
+// maskParser is a recursive descent parser for masks.
+type maskParser struct {
+	s   string
+	pos int
+}
+
+// parseList parses a comma separated list of fields, up to the end of the mask or a closing bracket.
+func (p *maskParser) parseList() (Mask, error) {
+	var m Mask
+	p.skipSpace()
+	if p.pos == len(p.s) {
+		return m, nil
+	}
+	for {
+		f, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, f)
+		p.skipSpace()
+		if p.pos == len(p.s) || p.s[p.pos] != ',' {
+			return m, nil
+		}
+		p.pos++
+	}
+}
+
+// parseField parses a field name and any nested fields.
+func (p *maskParser) parseField() (MaskField, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && isMaskNameChar(p.s[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		if p.pos == len(p.s) {
+			return MaskField{}, fmt.Errorf("missing field name at end")
+		}
+		return MaskField{}, fmt.Errorf("unexpected %q at %d", p.s[p.pos], p.pos)
+	}
+	f := MaskField{Name: p.s[start:p.pos]}
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == '{' {
+		p.pos++
+		fields, err := p.parseList()
+		if err != nil {
+			return MaskField{}, err
+		}
+		if p.pos == len(p.s) || p.s[p.pos] != '}' {
+			return MaskField{}, fmt.Errorf("missing } for %s", f.Name)
+		}
+		if len(fields) == 0 {
+			return MaskField{}, fmt.Errorf("no fields for %s", f.Name)
+		}
+		p.pos++
+		f.Fields = fields
+	}
+	return f, nil
+}
+
+// skipSpace advances past any whitespace.
+func (p *maskParser) skipSpace() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t' || p.s[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+// isMaskNameChar reports whether c can be part of a field name.
+func isMaskNameChar(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// String returns the mask in the syntax used by the API.
+func (m Mask) String() string {
+	var b strings.Builder
+	for i, f := range m {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(f.Name)
+		if len(f.Fields) > 0 {
+			b.WriteByte('{')
+			b.WriteString(f.Fields.String())
+			b.WriteByte('}')
+		}
+	}
+	return b.String()
+}
+
+// Items returns the fields selected for the items where the mask is for a list method, e.g.
+// deviceName for items{deviceName},page, and otherwise returns the mask itself.  This allows
+// the same mask to be used with the API and with bulk records.
+func (m Mask) Items() Mask {
+	for _, f := range m {
+		if strings.EqualFold(f.Name, "items") && len(f.Fields) > 0 {
+			return f.Fields
+		}
+	}
+	return m
+}
+
+// Fields returns the json names of the fields of v, which must be one of the model types or a
+// pointer to one, selected by the mask, in the order they appear in the mask.  Names are
+// matched ignoring case, so devicename selects deviceName.  Every field is returned, in field
+// order, for an empty mask.  The mask of a list method is applied to the items.  An error
+// wrapping ErrUnknownMaskField is returned where the mask has a field which v does not.
+func (m Mask) Fields(v interface{}) ([]string, error) {
+	columns, err := m.columns(reflect.TypeOf(v))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+	return names, nil
+}
+
+// Project returns the fields of v, which must be one of the model types or a pointer to one,
+// selected by the mask, keyed by their json names, omitting any which are nil.  The result
+// marshals to the same JSON as the record would, with only the selected fields.
+func (m Mask) Project(v interface{}) (map[string]interface{}, error) {
+	columns, err := m.columns(reflect.TypeOf(v))
+	if err != nil {
+		return nil, err
+	}
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	projection := make(map[string]interface{}, len(columns))
+	for _, c := range columns {
+		f := rv.Field(c.index)
+		if f.Kind() == reflect.Ptr && f.IsNil() {
+			continue
+		}
+		b, err := json.Marshal(f.Interface())
+		if err != nil {
+			return nil, err
+		}
+		projection[c.name] = json.RawMessage(b)
+	}
+	return projection, nil
+}
+
+// maskColumn is a field of a model type selected by a mask.
+type maskColumn struct {
+	name  string
+	index int
+}
+
+// columns resolves the mask for the struct type t, or a pointer to it.
+func (m Mask) columns(t reflect.Type) ([]maskColumn, error) {
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %v", ErrUnrecognisedBulkType, t)
+	}
+	var columns []maskColumn
+	mask := m.Items()
+	if len(mask) == 0 {
+		for i := 0; i < t.NumField(); i++ {
+			if name := jsonName(t.Field(i)); name != "" {
+				columns = append(columns, maskColumn{name: name, index: i})
+			}
+		}
+		return columns, nil
+	}
+	fields := jsonFields(t)
+	for _, f := range mask {
+		index, ok := fields[strings.ToLower(f.Name)]
+		if !ok || len(f.Fields) > 0 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownMaskField, f)
+		}
+		columns = append(columns, maskColumn{name: jsonName(t.Field(index)), index: index})
+	}
+	return columns, nil
+}
+
+// String returns the field in the syntax used by the API.
+func (f MaskField) String() string {
+	return Mask{f}.String()
+}
+
+// jsonName returns the json name of the struct field, or an empty string where it has none.
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
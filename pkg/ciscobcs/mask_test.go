@@ -0,0 +1,112 @@
+package ciscobcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMask(t *testing.T) {
+	for _, s := range []string{"", "deviceName", "items{devicename,productid},page,pages,total", "a{b{c,d},e},f"} {
+		m, err := ParseMask(s)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+			continue
+		}
+		if got := m.String(); got != s {
+			t.Errorf("got %q; want %q", got, s)
+		}
+	}
+	m, err := ParseMask(" items { devicename , productid } , page ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := m, ItemsMask("devicename", "productid")[:2]; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+	// the example in the API documentation has a stray bracket
+	for _, s := range []string{"items{devicename,productid}},page", "items{", "items{}", "a,", ",a", "a{b", "a-b"} {
+		if _, err := ParseMask(s); !errors.Is(err, ErrInvalidMask) {
+			t.Errorf("%s: got %v; want %v", s, err, ErrInvalidMask)
+		}
+	}
+
+	d := Device{
+		DeviceId:   Int(24932812),
+		DeviceName: String("lon-rtr-01"),
+		ProductId:  String("ASR1001-X"),
+	}
+	fields, err := ItemsMask("productid", "DEVICENAME", "swVersion").Fields(&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"productId", "deviceName", "swVersion"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("got %v; want %v", fields, want)
+	}
+	fields, err = Mask(nil).Fields(d)
+	if err != nil || len(fields) != reflect.TypeOf(d).NumField() || fields[0] != "collector" {
+		t.Errorf("got %v, %v; want every field", fields, err)
+	}
+	p, err := NewMask("deviceName", "deviceId", "swVersion").Project(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(p)
+	if got, want := string(b), `{"deviceId":24932812,"deviceName":"lon-rtr-01"}`; got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+	for _, m := range []Mask{NewMask("fieldNoticeId"), {{Name: "deviceName", Fields: NewMask("a")}}} {
+		if _, err := m.Project(d); !errors.Is(err, ErrUnknownMaskField) {
+			t.Errorf("%s: got %v; want %v", m, err, ErrUnknownMaskField)
+		}
+	}
+
+	t.Run("list", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			if got, want := r.URL.RawQuery, "mask=items%7BdeviceName%2CproductId%7D%2Cpage%2Cpages%2Ctotal"; got != want {
+				t.Errorf("got query %v; want %v", got, want)
+			}
+			w.Write([]byte(`{"page":1,"pages":1,"total":1,"items":[{"deviceName":"router1","productId":"ASR1001-X"}]}`))
+		})
+		opts := &ListOptions{Mask: ItemsMask("deviceName", "productId")}
+		if _, err := c.InventoryService.ListDevices(context.Background(), "12345", opts); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		results := demoResults(t)
+		var buf bytes.Buffer
+		cw := NewCSVWriter(&buf, ItemsMask("deviceName", "productId", "swVersion"))
+		for _, d := range results.Devices {
+			if err := cw.Write(d); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := cw.Write(results.PSIRTBulletins[0]); !errors.Is(err, ErrUnrecognisedBulkType) {
+			t.Errorf("got %v; want %v", err, ErrUnrecognisedBulkType)
+		}
+		if err := cw.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		rows, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != len(results.Devices)+1 {
+			t.Fatalf("got %v rows; want %v", len(rows), len(results.Devices)+1)
+		}
+		if want := []string{"deviceName", "productId", "swVersion"}; !reflect.DeepEqual(rows[0], want) {
+			t.Errorf("got header %v; want %v", rows[0], want)
+		}
+		d := results.Devices[0]
+		if want := []string{d.GetDeviceName(), d.GetProductId(), d.GetSwVersion()}; !reflect.DeepEqual(rows[1], want) {
+			t.Errorf("got %v; want %v", rows[1], want)
+		}
+	})
+}
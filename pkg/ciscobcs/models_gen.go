@@ -0,0 +1,981 @@
+// Code generated by convert-swagger-to-openapi; DO NOT EDIT.
+
+// Instead, please run "go generate ./..." from the root of the repository.
+
+package ciscobcs
+
+// Asset defines model for Asset.
+type Asset struct {
+	// The name of the chassis.  This is useful to reference child hardware to its parent chassis in a multi-chassis set-up.
+	ChassisName *string `json:"chassisName,omitempty"`
+
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// The Network Element Name of the device.  When used as input, it can include % as wildcard.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// The hardware revision.
+	HwRev *string `json:"hwRev,omitempty"`
+
+	// The amount of installed flash in the chassis (in megabytes).
+	InstalledFlash *int `json:"installedFlash,omitempty"`
+
+	// The amount of installed memory in the chassis (in megabytes).
+	InstalledMemory *int `json:"installedMemory,omitempty"`
+
+	// The printed circuit board (PCB) number of the hardware.
+	Pcb *string `json:"pcb,omitempty"`
+
+	// The printed circuit board (PCB) number revision of the hardware.
+	PcbRev *string `json:"pcbRev,omitempty"`
+
+	// The unique ID in NP for a specific piece of hardware.
+	PhysicalElementId *int `json:"physicalElementId,omitempty"`
+
+	// For chassis physicalType, this field will indicate which are IP-PHONE, LWAP, or UCSB.
+	PhysicalSubtype *string `json:"physicalSubtype,omitempty"`
+
+	// The physical type of the hardware.  Valid values are: Chassis, Module, Power Supply, Fan.
+	PhysicalType *string `json:"physicalType,omitempty"`
+
+	// The Cisco Product Family of the hardware.  Values come from MDF for Chassis.
+	ProductFamily *string `json:"productFamily,omitempty"`
+
+	// The Cisco Product ID (PID) of the hardware.
+	ProductId *string `json:"productId,omitempty"`
+
+	// The Cisco Product Type in COLD of the hardware.  Values usually come from MDF.
+	ProductType *string `json:"productType,omitempty"`
+
+	// The serial number of the hardware.
+	SerialNumber *string `json:"serialNumber,omitempty"`
+
+	// The validation status of the serial number of the hardware.  VALID means the SN was found in Cisco MFG or Contract DB.  INVALID means the SN was not found in either of those DBs.  UNKNOWN means the SN validation has been completed.  N/A means the SN is null, so validation is not applicable.
+	SerialNumberStatus *string `json:"serialNumberStatus,omitempty"`
+
+	// The slot where a hardware component is located in a chassis.
+	Slot *string `json:"slot,omitempty"`
+
+	// The Software Version of the device.
+	SwVersion *string `json:"swVersion,omitempty"`
+
+	// The Top Assembly Number (TAN) of the hardware.
+	Tan *string `json:"tan,omitempty"`
+
+	// The Top Assembly Number (TAN) Revision of the hardware.
+	TanRev *string `json:"tanRev,omitempty"`
+}
+
+// CBPDetails defines model for CBPDetails.
+type CBPDetails struct {
+	// The internal Config BP Nugget ID from the AI-X system.  Used to cross-reference with other API results.
+	BpNuggetId *int `json:"bpNuggetId,omitempty"`
+
+	// The internal Config BP Rule ID from the AI-X system.  Used to cross-reference with other API results.
+	BpRuleId *int `json:"bpRuleId,omitempty"`
+
+	// The source of a Configuration file.  The primary source will be "STANDARD".  But in some devices, it might be "CONTEXT" or "ADMIN".
+	ConfigSource *string `json:"configSource,omitempty"`
+
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+}
+
+// CBPRule defines model for CBPRules.
+type CBPRule struct {
+	// The Caveat associated with a Config BP Rule.
+	BpCaveat *string `json:"bpCaveat,omitempty"`
+
+	// The Corrective Action associated with a Config BP Rule.
+	BpCorrectiveAction *string `json:"bpCorrectiveAction,omitempty"`
+
+	// The Description associated with a Config BP Rule.
+	BpDescription *string `json:"bpDescription,omitempty"`
+
+	// The internal Config BP Nugget ID from the AI-X system.  Used to cross-reference with other API results.
+	BpNuggetId *int `json:"bpNuggetId,omitempty"`
+
+	// The Primary Technology associated with a Config BP Rule.
+	BpPrimaryTechnology *string `json:"bpPrimaryTechnology,omitempty"`
+
+	// The Recommendation associated with a Config BP Rule.
+	BpRecommendation *string `json:"bpRecommendation,omitempty"`
+
+	// The Risk associated with a Config BP Rule.  Valid values include: High, Medium, Low.
+	BpRisk *string `json:"bpRisk,omitempty"`
+
+	// The internal Config BP Rule ID from the AI-X system.  Used to cross-reference with other API results.
+	BpRuleId *int `json:"bpRuleId,omitempty"`
+
+	// The Secondary Technologies associated with a Config BP Rule.  Can be multiple values separated with commas.
+	BpSecondaryTechnology *string `json:"bpSecondaryTechnology,omitempty"`
+
+	// The Config BP exception headline / title.
+	BpTitle *string `json:"bpTitle,omitempty"`
+
+	// The date the record or rule was created in NP database.  For devices, a new record is created whenever a unique name+sysobjectid combination is seen in the collector.
+	CreateDate *DateTime `json:"createDate,omitempty"`
+
+	// The Type of Software running on the NP Network Element.  Common values include IOS, IOS XR, IOS-XE, NX-OS, etc.
+	SwType *string `json:"swType,omitempty"`
+
+	// The timestamp when the data or rule was last updated.
+	UpdateDate *DateTime `json:"updateDate,omitempty"`
+}
+
+// CBPRuleReference defines model for CBPRulesReferences.
+type CBPRuleReference struct {
+	// The internal Config BP Rule ID from the AI-X system.  Used to cross-reference with other API results.
+	BpRuleId *int `json:"bpRuleId,omitempty"`
+
+	// The Config BP reference URL.  Any rule can have 1 or more reference URLs.
+	BpUrl *string `json:"bpUrl,omitempty"`
+
+	// The Config BP reference URL Title associated with bpUrl.
+	BpUrlTitle *string `json:"bpUrlTitle,omitempty"`
+}
+
+// CBPSummary defines model for CBPSummary.
+type CBPSummary struct {
+	// The internal Config BP Nugget ID from the AI-X system.  Used to cross-reference with other API results.
+	BpNuggetId *int `json:"bpNuggetId,omitempty"`
+
+	// The Primary Technology associated with a Config BP Rule.
+	BpPrimaryTechnology *string `json:"bpPrimaryTechnology,omitempty"`
+
+	// The Risk associated with a Config BP Rule.  Valid values include: High, Medium, Low.
+	BpRisk *string `json:"bpRisk,omitempty"`
+
+	// The internal Config BP Rule ID from the AI-X system.  Used to cross-reference with other API results.
+	BpRuleId *int `json:"bpRuleId,omitempty"`
+
+	// The Secondary Technologies associated with a Config BP Rule.  Can be multiple values separated with commas.
+	BpSecondaryTechnology *string `json:"bpSecondaryTechnology,omitempty"`
+
+	// The Config BP exception headline / title.
+	BpTitle *string `json:"bpTitle,omitempty"`
+
+	// The Type of Software running on the NP Network Element.  Common values include IOS, IOS XR, IOS-XE, NX-OS, etc.
+	SwType *string `json:"swType,omitempty"`
+
+	// The number of unique matching devices in summary APIs.  For Custom Config and BP, this is the number of devices with exceptions.  For Feature, this is the number of devices with the feature match.  For tracks, this is the total number of devices in the track.
+	TotalDevices *int `json:"totalDevices,omitempty"`
+}
+
+// Collector defines model for collectorModel.
+type Collector struct {
+	// The id of the collector appliance
+	ApplianceId *string `json:"applianceId,omitempty"`
+
+	// The collector identifier, which can be either a 4 character collectorid or the applianceid.
+	Collector *string `json:"collector,omitempty"`
+
+	// Collector status
+	CollectorStatus *string `json:"collectorStatus,omitempty"`
+
+	// Collector version
+	CollectorVersion *string `json:"collectorVersion,omitempty"`
+
+	// Expected collection interval in days
+	ExpectedUploadInterval *int `json:"expectedUploadInterval,omitempty"`
+
+	// The date timestamp of the last completed collection for this collector
+	LastUploadComplete *DateTime `json:"lastUploadComplete,omitempty"`
+}
+
+// ContractBasePID defines model for contractBasePID.
+type ContractBasePID struct {
+	// Base or manufacturing product identifiers related to the specified serial number.
+	BasePid *string `json:"basePid,omitempty"`
+}
+
+// CrashRisk defines model for crashRisk.
+type CrashRisk struct {
+	CiscoMlEtrees     *float32 `json:"ciscoMlEtrees,omitempty"`
+	CiscoMlKmeans     *float32 `json:"ciscoMlKmeans,omitempty"`
+	CiscoMlLatent     *float32 `json:"ciscoMlLatent,omitempty"`
+	CiscoMlNeighbors  *float32 `json:"ciscoMlNeighbors,omitempty"`
+	CiscoMlNeuralnets *float32 `json:"ciscoMlNeuralnets,omitempty"`
+	CiscoMlTopics     *float32 `json:"ciscoMlTopics,omitempty"`
+	CiscoMlTotal      *float32 `json:"ciscoMlTotal,omitempty"`
+	DeviceHigh        *float32 `json:"deviceHigh,omitempty"`
+
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId  *int     `json:"deviceId,omitempty"`
+	DeviceLow *float32 `json:"deviceLow,omitempty"`
+
+	// The Network Element Name of the device.  When used as input, it can include % as wildcard.
+	DeviceName     *string  `json:"deviceName,omitempty"`
+	DeviceRisk     *float32 `json:"deviceRisk,omitempty"`
+	GlobalRiskRank *string  `json:"globalRiskRank,omitempty"`
+
+	// The Cisco Product Family of the hardware.  Values come from MDF for Chassis.
+	ProductFamily *string `json:"productFamily,omitempty"`
+
+	// The Cisco Product ID (PID) of the hardware.
+	ProductId *string `json:"productId,omitempty"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// Device defines model for Device.
+type Device struct {
+	// The collector identifier, which can be either a 4 character collectorid or the applianceid.
+	Collector *string `json:"collector,omitempty"`
+
+	// The Configuration register of the device.
+	ConfigRegister *string `json:"configRegister,omitempty"`
+
+	// The status of Configuration collection.  Completed means the config was successfully collected.  NotAvailable means the config was not collected.  NotSupported means the device does not support collection of an ASCii config via CLI.
+	ConfigStatus *string `json:"configStatus,omitempty"`
+
+	// The time when the collector last successfully collected the configuration from the device.
+	ConfigTime *DateTime `json:"configTime,omitempty"`
+
+	// The date the record or rule was created in NP database.  For devices, a new record is created whenever a unique name+sysobjectid combination is seen in the collector.
+	CreateDate *DateTime `json:"createDate,omitempty"`
+
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// The management IP address of the device.
+	DeviceIp *string `json:"deviceIp,omitempty"`
+
+	// The Network Element Name of the device.  When used as input, it can include % as wildcard.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// The status of the device as reported by the collector.  Usually will be either ACTIVE or DEVICE NOT REACHABLE.
+	DeviceStatus *string `json:"deviceStatus,omitempty"`
+
+	// The hostname (SNMP sysName) of the device.  It will be fully-qualified name, if domain name is set on the device.
+	DeviceSysName *string `json:"deviceSysName,omitempty"`
+
+	// The type of the device.  Values include Managed Chassis, Managed Multi-Chassis, SDR, Contexts, and IOS-XR Admin
+	DeviceType *string `json:"deviceType,omitempty"`
+
+	// The name of the software feature set running on the device.  This data is primarily available for IOS.
+	FeatureSetdesc *string `json:"featureSetdesc,omitempty"`
+
+	// The Image Name of the software on the Network Element.
+	ImageName *string `json:"imageName,omitempty"`
+
+	// Indicates whether the device is in a collector seedfile (true) or has been logically created by NP (false).  This is important for some KPI measurements to be accurate.
+	InSeedFile *bool `json:"inSeedFile,omitempty"`
+
+	// The status of Inventory collection.  Completed means some SNMP inventory was successfully collected.  NotAvailable means SNMP inventory was not collected.  NotSupported means the device was not in CSPC to be collected.
+	InventoryStatus *string `json:"inventoryStatus,omitempty"`
+
+	// The time when the collector last successfully collected inventory from the device.
+	InventoryTime *DateTime `json:"inventoryTime,omitempty"`
+
+	// An IPv4 Address.
+	IpAddress *string `json:"ipAddress,omitempty"`
+
+	// The date timestamp of the last reset of the device as reported by the show version command.
+	LastReset *DateTime `json:"lastReset,omitempty"`
+
+	// The Cisco Product Family of the hardware.  Values come from MDF for Chassis.
+	ProductFamily *string `json:"productFamily,omitempty"`
+
+	// The Cisco Product ID (PID) of the hardware.
+	ProductId *string `json:"productId,omitempty"`
+
+	// The Cisco Product Type in COLD of the hardware.  Values usually come from MDF.
+	ProductType *string `json:"productType,omitempty"`
+
+	// The reason for the last system reset as reported in the show version output.
+	ResetReason *string `json:"resetReason,omitempty"`
+
+	// The Type of Software running on the NP Network Element.  Common values include IOS, IOS XR, IOS-XE, NX-OS, etc.
+	SwType *string `json:"swType,omitempty"`
+
+	// The Software Version of the device.
+	SwVersion *string `json:"swVersion,omitempty"`
+
+	// The SNMP sysContact of the device which is populated in most devices using a configuration command.
+	SysContact *string `json:"sysContact,omitempty"`
+
+	// The SNMP system description from the device.
+	SysDescription *string `json:"sysDescription,omitempty"`
+
+	// The SNMP sysLocation of the device which is populated in most devices using a configuration command.
+	SysLocation *string `json:"sysLocation,omitempty"`
+
+	// The SNMP sysObjectID of the device.
+	SysObjectId *string `json:"sysObjectId,omitempty"`
+
+	// The user field1 value populated in the collector seedfile.
+	UserField1 *string `json:"userField1,omitempty"`
+
+	// The user field2 value populated in the collector seedfile.
+	UserField2 *string `json:"userField2,omitempty"`
+
+	// The user field3 value populated in the collector seedfile.
+	UserField3 *string `json:"userField3,omitempty"`
+
+	// The user field4 value populated in the collector seedfile.
+	UserField4 *string `json:"userField4,omitempty"`
+}
+
+// ErrorResponse defines model for Error.
+type ErrorResponse struct {
+	// Error message
+	Message *string `json:"message,omitempty"`
+
+	// Tracking ID for troubleshooting
+	TrackingId *string `json:"trackingId,omitempty"`
+}
+
+// FNBulletin defines model for FNBulletins.
+type FNBulletin struct {
+	// The date when the bulletin was first published to Cisco.com.  Most API calls will allow Regex input for this field.
+	BulletinFirstPublished *Timestamp `json:"bulletinFirstPublished,omitempty"`
+
+	// The date when the bulletin was last updated on Cisco.com.
+	BulletinLastUpdated *DateTime `json:"bulletinLastUpdated,omitempty"`
+
+	// The Bulletin Mapping Caveat gives any explanations why the automation may need additional review by the customer.
+	BulletinMappingCaveat *string `json:"bulletinMappingCaveat,omitempty"`
+
+	// The Cisco.com Title/Headline for the bulletin.
+	BulletinTitle *string `json:"bulletinTitle,omitempty"`
+
+	// The Cisco.com URL for the bulletin.
+	BulletinUrl *string `json:"bulletinUrl,omitempty"`
+
+	// Field Notice ID number.
+	FieldNoticeId *string `json:"fieldNoticeId,omitempty"`
+
+	// Type of Field Notice as defined from PLATO.  Valid values include: hardware, software, other
+	FnType *string `json:"fnType,omitempty"`
+
+	// The description of the problem on a Cisco bulletin.
+	ProblemDescription *string `json:"problemDescription,omitempty"`
+}
+
+// Feedback defines model for feedbackModel.
+type Feedback struct {
+	// The name of the app related to the feedback
+	App *string `json:"app,omitempty"`
+
+	// The email address of the user
+	Email *string `json:"email,omitempty"`
+
+	// The feedback, freeform text
+	Feedback *string `json:"feedback,omitempty"`
+
+	// The id in the database
+	Id *int `json:"id,omitempty"`
+
+	// The feedback rating, out of 5
+	Rating *int `json:"rating,omitempty"`
+
+	// The name of the screen view within the app  related to the feedback
+	Screen *string `json:"screen,omitempty"`
+
+	// DateTime of insertion
+	Time *DateTime `json:"time,omitempty"`
+}
+
+// FieldNotice defines model for fieldNotices.
+type FieldNotice struct {
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// Field Notice ID number.
+	FieldNoticeId *string `json:"fieldNoticeId,omitempty"`
+
+	// The match confidence result from PAS.  Valid values include: Vulnerable, Potentially Vulnerable, Not Vulnerable.
+	MatchConfidence *string `json:"matchConfidence,omitempty"`
+
+	// The reason behind the match confidence result from PAS.  Explains why you are vulnerable or not vulnerable or what data is missing to cause a potentially vulnerable result.  PAS value is enhanced in NP for readability.
+	MatchConfidenceReason *string `json:"matchConfidenceReason,omitempty"`
+
+	// The unique ID in NP for a specific piece of hardware.
+	PhysicalElementId *int `json:"physicalElementId,omitempty"`
+}
+
+// HWEOX defines model for HWEOX.
+type HWEOX struct {
+	// The current end-of-life milestone as calculated during last NP profile.  If more than one milestone falls on the same date, the returned value will be a comma-separated list.
+	CurrentEoxMilestone *string `json:"currentEoxMilestone,omitempty"`
+
+	// The date associated with the current end-of-life milestone.
+	CurrentEoxMilestoneDate *DateTime `json:"currentEoxMilestoneDate,omitempty"`
+
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// The Network Element Name of the device.  When used as input, it can include % as wildcard.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// Internal hardware end-of-life identifier to allow join with master hw_eox_bulletins API.
+	HwEoxId *int `json:"hwEoxId,omitempty"`
+
+	// The next end-of-life milestone that is coming up as calculated during last NP profile.  If more than one milestone falls on the same date, the returned value will be a comma-separated list.  If the device is already LDoS it will not have an next milestone.
+	NextEoxMilestone *string `json:"nextEoxMilestone,omitempty"`
+
+	// The date associated with the next end-of-life milestone.
+	NextEoxMilestoneDate *DateTime `json:"nextEoxMilestoneDate,omitempty"`
+
+	// The unique ID in NP for a specific piece of hardware.
+	PhysicalElementId *int `json:"physicalElementId,omitempty"`
+
+	// The physical type of the hardware.  Valid values are: Chassis, Module, Power Supply, Fan.
+	PhysicalType *string `json:"physicalType,omitempty"`
+
+	// The Cisco Product ID (PID) of the hardware.
+	ProductId *string `json:"productId,omitempty"`
+}
+
+// HWEOXBulletin defines model for HWEOXBulletins.
+type HWEOXBulletin struct {
+	// The Cisco.com bulletin number for an End-of-Life bulletin or Field Notice.
+	BulletinNumber *string `json:"bulletinNumber,omitempty"`
+
+	// The Cisco.com Title/Headline for the bulletin.
+	BulletinTitle *string `json:"bulletinTitle,omitempty"`
+
+	// The Cisco.com URL for the bulletin.
+	BulletinUrl *string `json:"bulletinUrl,omitempty"`
+
+	// The End-of-Life Announcement (Announced) Date.
+	EoLifeAnnouncementDate *DateTime `json:"eoLifeAnnouncementDate,omitempty"`
+
+	// The End of New Service Attachment Date.
+	EoNewServiceAttachDate *DateTime `json:"eoNewServiceAttachDate,omitempty"`
+
+	// The End of Routine Failure Analysis Date (EoRFA) Date.
+	EoRoutineFailureAnalysisDate *DateTime `json:"eoRoutineFailureAnalysisDate,omitempty"`
+
+	// The End-of-Sale (EoSale) Date.
+	EoSaleDate *DateTime `json:"eoSaleDate,omitempty"`
+
+	// The End of Vulnerability/Security Support (EoVSS) Date.
+	EoSecurityVulSupportDate *DateTime `json:"eoSecurityVulSupportDate,omitempty"`
+
+	// The End of Service Contract Renewal (EoSCR) Date.
+	EoSoftwareContractRenewalDate *DateTime `json:"eoSoftwareContractRenewalDate,omitempty"`
+
+	// The End of SW Maintenance Releases (EoSWM) Date.
+	EoSwMaintenanceReleasesDate *DateTime `json:"eoSwMaintenanceReleasesDate,omitempty"`
+
+	// Internal hardware end-of-life identifier to allow join with master hw_eox_bulletins API.
+	HwEoxId *int `json:"hwEoxId,omitempty"`
+
+	// The Last Date of Support (LDoS).
+	LastDateOfSupport *DateTime `json:"lastDateOfSupport,omitempty"`
+
+	// The Last Ship Date.
+	LastShipDate *DateTime `json:"lastShipDate,omitempty"`
+
+	// The Cisco Product ID (PID) of the hardware.
+	ProductId *string `json:"productId,omitempty"`
+}
+
+// ItemCount defines model for itemCount.
+type ItemCount struct {
+	// The total amount of items
+	Count *int `json:"count,omitempty"`
+
+	// Date of insertion
+	Date *Date `json:"date,omitempty"`
+
+	// The name of table
+	TableName *string `json:"tableName,omitempty"`
+}
+
+// OrderablePid defines model for orderablePid.
+type OrderablePid struct {
+	// Orderable product description for the specified serial number
+	ItemDescription *string `json:"itemDescription,omitempty"`
+
+	// Orderable product position for the specified serial number
+	ItemPosition *string `json:"itemPosition,omitempty"`
+
+	// Orderable product type for the specified serial number
+	ItemType *string `json:"itemType,omitempty"`
+
+	// Orderable product identifiers for the specified serial number
+	OrderablePid *string `json:"orderablePid,omitempty"`
+
+	// Orderable product identifiers for the specified serial number
+	PillarCode *string `json:"pillarCode,omitempty"`
+}
+
+// PSIRTBulletin defines model for PSIRTBulletins.
+type PSIRTBulletin struct {
+	// The date when the bulletin was first published to Cisco.com.  Most API calls will allow Regex input for this field.
+	BulletinFirstPublished *Timestamp `json:"bulletinFirstPublished,omitempty"`
+
+	// The date when the bulletin was last updated on Cisco.com.
+	BulletinLastUpdated *DateTime `json:"bulletinLastUpdated,omitempty"`
+
+	// The Bulletin Mapping Caveat gives any explanations why the automation may need additional review by the customer.
+	BulletinMappingCaveat *string `json:"bulletinMappingCaveat,omitempty"`
+
+	// The Summary of a Cisco.com bulletin.
+	BulletinSummary *string `json:"bulletinSummary,omitempty"`
+
+	// The Cisco.com Title/Headline for the bulletin.
+	BulletinTitle *string `json:"bulletinTitle,omitempty"`
+
+	// The Cisco.com URL for the bulletin.
+	BulletinUrl *string `json:"bulletinUrl,omitempty"`
+
+	// The version # of the Cisco.com bulletin.
+	BulletinVersion *string `json:"bulletinVersion,omitempty"`
+
+	// Comma-separated list of Cisco Bug IDs.
+	CiscoBugIds *string `json:"ciscoBugIds,omitempty"`
+
+	// Common Vulnerabilities and Exposures (CVE) Identifier
+	CveId *string `json:"cveId,omitempty"`
+
+	// Common Vulnerability Scoring System (CVSS) Base Score
+	CvssBase *string `json:"cvssBase,omitempty"`
+
+	// Common Vulnerability Scoring System (CVSS) Temporal Score
+	CvssTemporal *string `json:"cvssTemporal,omitempty"`
+
+	// The Advisory ID of a PSIRT as seen on Cisco.com.
+	PsirtAdvisoryId *string `json:"psirtAdvisoryId,omitempty"`
+
+	// The internal COLD ID for a PSIRT.  This is useful for joining multiple data sources.
+	PsirtColdId *int `json:"psirtColdId,omitempty"`
+
+	// The Security Impact Rating (SIR) for Cisco PSIRTs.
+	Sir *string `json:"sir,omitempty"`
+}
+
+// Page defines model for pageOfResults.
+type Page struct {
+	// Number of page of results
+	Page *int `json:"page,omitempty"`
+
+	// Total number of pages of results
+	Pages *int `json:"pages,omitempty"`
+
+	// Number of items per page of results
+	PerPage *int `json:"perPage,omitempty"`
+
+	// Total number of results
+	Total *int `json:"total,omitempty"`
+}
+
+// PageOfAssets defines model for pageOfAssets.
+type PageOfAssets struct {
+	Page
+	Items []Asset `json:"items,omitempty"`
+}
+
+// PageOfCBPdetails defines model for pageOfCBPdetails.
+type PageOfCBPdetails struct {
+	Page
+	Items []CBPDetails `json:"items,omitempty"`
+}
+
+// PageOfCBPrules defines model for pageOfCBPrules.
+type PageOfCBPrules struct {
+	Page
+	Items []CBPRule `json:"items,omitempty"`
+}
+
+// PageOfCBPrulesreferences defines model for pageOfCBPrulesreferences.
+type PageOfCBPrulesreferences struct {
+	Page
+	Items []CBPRuleReference `json:"items,omitempty"`
+}
+
+// PageOfCBPsummary defines model for pageOfCBPsummary.
+type PageOfCBPsummary struct {
+	Page
+	Items []CBPSummary `json:"items,omitempty"`
+}
+
+// PageOfCollectors defines model for pageOfCollectors.
+type PageOfCollectors struct {
+	Page
+	Items []Collector `json:"items,omitempty"`
+}
+
+// PageOfCountDataPoint defines model for pageOfCountDataPoint.
+type PageOfCountDataPoint struct {
+	Page
+	Items []ItemCount `json:"items,omitempty"`
+}
+
+// PageOfCrashes defines model for pageOfCrashes.
+type PageOfCrashes struct {
+	Page
+	Items []CrashRisk `json:"items,omitempty"`
+}
+
+// PageOfDevices defines model for pageOfDevices.
+type PageOfDevices struct {
+	Page
+	Items []Device `json:"items,omitempty"`
+}
+
+// PageOfFNBulletins defines model for pageOfFNBulletins.
+type PageOfFNBulletins struct {
+	Page
+	Items []FNBulletin `json:"items,omitempty"`
+}
+
+// PageOfFeedback defines model for pageOfFeedback.
+type PageOfFeedback struct {
+	Page
+	Items []Feedback `json:"items,omitempty"`
+}
+
+// PageOfFieldNotices defines model for pageOfFieldNotices.
+type PageOfFieldNotices struct {
+	Page
+	Items []FieldNotice `json:"items,omitempty"`
+}
+
+// PageOfHWEOX defines model for pageOfHWEOX.
+type PageOfHWEOX struct {
+	Page
+	Items []HWEOX `json:"items,omitempty"`
+}
+
+// PageOfHWEOXBulletins defines model for pageOfHWEOXBulletins.
+type PageOfHWEOXBulletins struct {
+	Page
+	Items []HWEOXBulletin `json:"items,omitempty"`
+}
+
+// PageOfPSIRTBulletins defines model for pageOfPSIRTBulletins.
+type PageOfPSIRTBulletins struct {
+	Page
+	Items []PSIRTBulletin `json:"items,omitempty"`
+}
+
+// PageOfSWEOXBulletins defines model for pageOfSWEOXBulletins.
+type PageOfSWEOXBulletins struct {
+	Page
+	Items []SWEOXBulletin `json:"items,omitempty"`
+}
+
+// PageOfSecurityAdvisories defines model for pageOfSecurityAdvisories.
+type PageOfSecurityAdvisories struct {
+	Page
+	Items []SecurityAdvisory `json:"items,omitempty"`
+}
+
+// PageOfSerialNumberDetails defines model for pageOfSerialNumberDetails.
+type PageOfSerialNumberDetails struct {
+	Page
+	Items []SerialNumberDetails `json:"items,omitempty"`
+}
+
+// PageOfSoftwareAlerts defines model for pageOfSoftwareAlerts.
+type PageOfSoftwareAlerts struct {
+	Page
+	Items []SoftwareAlert `json:"items,omitempty"`
+}
+
+// PageOfSoftwareEOX defines model for pageOfSoftwareEOX.
+type PageOfSoftwareEOX struct {
+	Page
+	Items []SoftwareEOX `json:"items,omitempty"`
+}
+
+// SWEOXBulletin defines model for SWEOXBulletins.
+type SWEOXBulletin struct {
+	// The Cisco.com bulletin number for an End-of-Life bulletin or Field Notice.
+	BulletinNumber *string `json:"bulletinNumber,omitempty"`
+
+	// The Cisco.com Title/Headline for the bulletin.
+	BulletinTitle *string `json:"bulletinTitle,omitempty"`
+
+	// The Cisco.com URL for the bulletin.
+	BulletinUrl *string `json:"bulletinUrl,omitempty"`
+
+	// The End-of-Life Announcement (Announced) Date.
+	EoLifeAnnouncementDate *DateTime `json:"eoLifeAnnouncementDate,omitempty"`
+
+	// The End-of-Sale (EoSale) Date.
+	EoSaleDate *DateTime `json:"eoSaleDate,omitempty"`
+
+	// The End of Vulnerability/Security Support (EoVSS) Date.
+	EoSecurityVulSupportDate *DateTime `json:"eoSecurityVulSupportDate,omitempty"`
+
+	// The End of SW Maintenance Releases (EoSWM) Date.
+	EoSwMaintenanceReleasesDate *DateTime `json:"eoSwMaintenanceReleasesDate,omitempty"`
+
+	// The Last Date of Support (LDoS).
+	LastDateOfSupport *DateTime `json:"lastDateOfSupport,omitempty"`
+
+	// Internal software end-of-life identifier to allow join with master sw_eox_bulletins API.
+	SwEoxId *int `json:"swEoxId,omitempty"`
+
+	// The maintenance version portion of the software version.  For example, in 12.4(21), it is "21"
+	SwMaintenanceVersion *string `json:"swMaintenanceVersion,omitempty"`
+
+	// The major version portion of the software version.
+	SwMajorVersion *string `json:"swMajorVersion,omitempty"`
+
+	// The Software Train, typically only applies to IOS.
+	SwTrain *string `json:"swTrain,omitempty"`
+
+	// The Type of Software running on the NP Network Element.  Common values include IOS, IOS XR, IOS-XE, NX-OS, etc.
+	SwType *string `json:"swType,omitempty"`
+}
+
+// SecurityAdvisory defines model for securityAdvisories.
+type SecurityAdvisory struct {
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// The match confidence result from PAS.  Valid values include: Vulnerable, Potentially Vulnerable, Not Vulnerable.
+	MatchConfidence *string `json:"matchConfidence,omitempty"`
+
+	// The reason behind the match confidence result from PAS.  Explains why you are vulnerable or not vulnerable or what data is missing to cause a potentially vulnerable result.  PAS value is enhanced in NP for readability.
+	MatchConfidenceReason *string `json:"matchConfidenceReason,omitempty"`
+
+	// The internal COLD ID for a PSIRT.  This is useful for joining multiple data sources.
+	PsirtColdId *int `json:"psirtColdId,omitempty"`
+}
+
+// SerialNumberDetails defines model for serialNumberDetails.
+type SerialNumberDetails struct {
+	BasePidList []ContractBasePID `json:"basePidList,omitempty"`
+
+	// Address field for the contract install site.
+	ContractSiteAddress1 *string `json:"contractSiteAddress1,omitempty"`
+
+	// City field for the contract install site; for example,
+	ContractSiteCity *string `json:"contractSiteCity,omitempty"`
+
+	// Country field for the contract install site
+	ContractSiteCountry *string `json:"contractSiteCountry,omitempty"`
+
+	// Customer name associated to the contract install site.
+	ContractSiteCustomerName *string `json:"contractSiteCustomerName,omitempty"`
+
+	// State field for the contract install site
+	ContractSiteStateProvince *string `json:"contractSiteStateProvince,omitempty"`
+
+	// End date of the covered product line in the following format: YYYY-MM-DD
+	CoveredProductLineEndDate *Date `json:"coveredProductLineEndDate,omitempty"`
+
+	// Number of the record in the results.
+	Id *int `json:"id,omitempty"`
+
+	// Indicates whether the specified serial number is covered by a service contract; one of the following values: YES or NO. If the serial number is covered by a service contract, the value is Yes.
+	IsCovered        *string        `json:"isCovered,omitempty"`
+	OrderablePidList []OrderablePid `json:"orderablePidList,omitempty"`
+
+	// Parent serial number. The value of parent_sr_no will be the same as the value for sr_no if the item is a MAJOR item.
+	ParentSrNo *string `json:"parentSrNo,omitempty"`
+
+	// Service contract number
+	ServiceContractNumber *string `json:"serviceContractNumber,omitempty"`
+
+	// Description of the service type
+	ServiceLineDescr *string `json:"serviceLineDescr,omitempty"`
+
+	// Serial number of the device.
+	SrNo *string `json:"srNo,omitempty"`
+
+	// End date of the warranty for the specified serial number in the following format: YYYY-MM-DD
+	WarrantyEndDate *Date `json:"warrantyEndDate,omitempty"`
+
+	// Warranty service type
+	WarrantyType *string `json:"warrantyType,omitempty"`
+
+	// Link to the description of the warranty type.
+	WarrantyTypeDescription *string `json:"warrantyTypeDescription,omitempty"`
+}
+
+// SoftwareAlert defines model for softwareAlerts.
+type SoftwareAlert struct {
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// The Network Element Name of the device.  When used as input, it can include % as wildcard.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// The Image Name of the software on the Network Element.
+	ImageName *string `json:"imageName,omitempty"`
+
+	// The type of Software Alert on the device.  Valid values include SA for Software Advisory and DF for Deferral.
+	SwAlertType *string `json:"swAlertType,omitempty"`
+
+	// The Cisco.com URL with details for a specific software advisory or deferral.
+	SwAlertUrl *string `json:"swAlertUrl,omitempty"`
+
+	// The Type of Software running on the NP Network Element.  Common values include IOS, IOS XR, IOS-XE, NX-OS, etc.
+	SwType *string `json:"swType,omitempty"`
+
+	// The Software Version of the device.
+	SwVersion *string `json:"swVersion,omitempty"`
+}
+
+// SoftwareEOX defines model for softwareEOX.
+type SoftwareEOX struct {
+	// The current end-of-life milestone as calculated during last NP profile.  If more than one milestone falls on the same date, the returned value will be a comma-separated list.
+	CurrentEoxMilestone *string `json:"currentEoxMilestone,omitempty"`
+
+	// The date associated with the current end-of-life milestone.
+	CurrentEoxMilestoneDate *DateTime `json:"currentEoxMilestoneDate,omitempty"`
+
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// The Network Element Name of the device.  When used as input, it can include % as wildcard.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// The next end-of-life milestone that is coming up as calculated during last NP profile.  If more than one milestone falls on the same date, the returned value will be a comma-separated list.  If the device is already LDoS it will not have an next milestone.
+	NexteoxMilestone *string `json:"nexteoxMilestone,omitempty"`
+
+	// The date associated with the next end-of-life milestone.
+	NexteoxMilestoneDate *DateTime `json:"nexteoxMilestoneDate,omitempty"`
+
+	// Internal software end-of-life identifier to allow join with master sw_eox_bulletins API.
+	SwEoxId *int `json:"swEoxId,omitempty"`
+
+	// The Type of Software running on the NP Network Element.  Common values include IOS, IOS XR, IOS-XE, NX-OS, etc.
+	SwType *string `json:"swType,omitempty"`
+
+	// The Software Version of the device.
+	SwVersion *string `json:"swVersion,omitempty"`
+}
+
+// TrackCompliance defines model for complianceModel.
+type TrackCompliance struct {
+	// The unique ID of the NP NetworkElement/Device.
+	DeviceId *int `json:"deviceId,omitempty"`
+
+	// The status of the SMU or PIE, which is either Active or Committed
+	SmuPieType *string `json:"smuPieType,omitempty"`
+
+	// The Name of the Software running on the NP Network Element.  For System SW, the value is the Image Name.  For PIE it is the package name and for SMU the SMU name.
+	SwName *string `json:"swName,omitempty"`
+
+	// The Role of the Software running on the NP Network Element.  Values include SYSTEM, PKG, SMU
+	SwRole *string `json:"swRole,omitempty"`
+
+	// Action to take for a specific SMU/PIE for a device.  "Add" means the SMU/PIE needs to be installed on the device to make it compliant.  "Delete" means the SMU/PIE needs to be removed from the device to make it compliant.  "None" means no action needs to be taken.
+	TrackDeviceSmuPieAction *string `json:"trackDeviceSmuPieAction,omitempty"`
+
+	// Compliance status for a specific SMU/PIE for a device. "Compliant" means the SMU/PIE matches the recommendation.  "Non-Compliant" means the SMU/PIE does not match the recommended list.  See the action field for steps to take.  "Extra" means the SMU/PIE isn't part of the recommended list, but that is okay because exact match isn't being used.
+	TrackDeviceSmuPieCompliant *string `json:"trackDeviceSmuPieCompliant,omitempty"`
+
+	// Internal NP Software Track identifier.  This is needed to join between various track API results.
+	TrackId *int `json:"trackId,omitempty"`
+
+	// NP Software Track Name.
+	TrackName *string `json:"trackName,omitempty"`
+}
+
+// TrackSmupieRecommendation defines model for recomendationsModel.
+type TrackSmupieRecommendation struct {
+	// The Name of the Software running on the NP Network Element.  For System SW, the value is the Image Name.  For PIE it is the package name and for SMU the SMU name.
+	SwName *string `json:"swName,omitempty"`
+
+	// The Role of the Software running on the NP Network Element.  Values include SYSTEM, PKG, SMU
+	SwRole *string `json:"swRole,omitempty"`
+
+	// Internal NP Software Track identifier.  This is needed to join between various track API results.
+	TrackId *int `json:"trackId,omitempty"`
+
+	// NP Software Track Name.
+	TrackName *string `json:"trackName,omitempty"`
+
+	// This field indicates recommendation history value of the track SMUs/PIEs.  "Current" is for the standard recommendation and should be used in most cases.  "Previous1" is for the previous recommendation.  "Previous2" is for the 2nd previous recommendation. "Candidate" is for the future candidate recommendation.
+	TrackRecHistory *string `json:"trackRecHistory,omitempty"`
+}
+
+// TrackSummary defines model for summaryModel.
+type TrackSummary struct {
+	// The Type of Software running on the NP Network Element.  Common values include IOS, IOS XR, IOS-XE, NX-OS, etc.
+	SwType *string `json:"swType,omitempty"`
+
+	// The candidate/future recommended standard version for the NP Software Track.
+	TrackCandidateSwVersion *string `json:"trackCandidateSwVersion,omitempty"`
+
+	// NP Software Track Recommendation and Planning Comments.
+	TrackComments *string `json:"trackComments,omitempty"`
+
+	// Total number of devices in the NP Software Track that are running the Standard Recommended Version.
+	TrackCompliantDevices *int `json:"trackCompliantDevices,omitempty"`
+
+	// NP Software Track Description.
+	TrackDescription *string `json:"trackDescription,omitempty"`
+
+	// Internal NP Software Track identifier.  This is needed to join between various track API results.
+	TrackId *int `json:"trackId,omitempty"`
+
+	// The date when the software track was last edited or modified in Network Profile.
+	TrackLastModifiedDate *Date `json:"trackLastModifiedDate,omitempty"`
+
+	// NP Software Track Name.
+	TrackName *string `json:"trackName,omitempty"`
+
+	// Total number of devices in the NP Software Track that are not running the Standard Recommended Version.
+	TrackNonCompliantDevices *int `json:"trackNonCompliantDevices,omitempty"`
+
+	// The percent of devices running the standard recommended version.  Formula is trackCompliantDevices/trackTotalDevices.
+	TrackPercentCompliant *float32 `json:"trackPercentCompliant,omitempty"`
+
+	// The percent of devices running the standard recommended version or one of the two previous recommended versions.  Formula is (trackCompliantDevices+trackPrevCompliantDevices)/trackTotalDevices.
+	TrackPercentFlexibleCompliant *float32 `json:"trackPercentFlexibleCompliant,omitempty"`
+
+	// The PIE matching criteria for the previous standard version for the NP Software Track.  Valid values include:  Require Exact Match, Ignore For Conformance, Match but ignore extras
+	TrackPrev1PieCriteria *string `json:"trackPrev1PieCriteria,omitempty"`
+
+	// The SMU matching criteria for the previous standard version for the NP Software Track.  Valid values include:  Require Exact Match, Ignore For Conformance, Match but ignore extras
+	TrackPrev1SmuCriteria *string `json:"trackPrev1SmuCriteria,omitempty"`
+
+	// The PIE matching criteria for the 2nd previous standard version for the NP Software Track.  Valid values include:  Require Exact Match, Ignore For Conformance, Match but ignore extras
+	TrackPrev2PieCriteria *string `json:"trackPrev2PieCriteria,omitempty"`
+
+	// The SMU matching criteria for the 2nd previous standard version for the NP Software Track.  Valid values include:  Require Exact Match, Ignore For Conformance, Match but ignore extras
+	TrackPrev2SmuCriteria *string `json:"trackPrev2SmuCriteria,omitempty"`
+
+	// Total number of devices in the NP Software Track that are running the Standard Recommended Version.
+	TrackPrevCompliantDevices *int `json:"trackPrevCompliantDevices,omitempty"`
+
+	// The previous recommended standard version for the NP Software Track.
+	TrackPrevSwVersion1 *string `json:"trackPrevSwVersion1,omitempty"`
+
+	// The 2nd previous recommended standard version for the NP Software Track.
+	TrackPrevSwVersion2 *string `json:"trackPrevSwVersion2,omitempty"`
+
+	// The AS rating of the track compliance.  Results depend on whether account is using Absolute Compliance (default) or Flexible Compliance.  For Absolute, %Compliant 90 and above is Good, 60-90 is Fair, and below 60 is Poor.  For Flexible, it is the same thresholds, but %FlexibleCompliant is used.
+	TrackRating *string `json:"trackRating,omitempty"`
+
+	// The date when the last software recommendation was made.  This is manually set by the user in the NP Software Track.  This is used to measure the age of the recommendation.
+	TrackRecommendationDate *Date `json:"trackRecommendationDate,omitempty"`
+
+	// The overall compliance percentage of the devices to the recommended SMU list.  Extra SMUs are ignored for the calculation.
+	TrackSmuCompliancePercent *float32 `json:"trackSmuCompliancePercent,omitempty"`
+
+	// The PIE matching criteria for the recommended standard version for the NP Software Track.  Valid values include:  Require Exact Match, Ignore For Conformance, Match but ignore extras
+	TrackStandardPieCriteria *string `json:"trackStandardPieCriteria,omitempty"`
+
+	// The number of SMUs in the Standard Recommendation for the track.
+	TrackStandardSmuCount *int `json:"trackStandardSmuCount,omitempty"`
+
+	// The SMU matching criteria for the recommended standard version for the NP Software Track.  Valid values include:  Require Exact Match, Ignore For Conformance, Match but ignore extras
+	TrackStandardSmuCriteria *string `json:"trackStandardSmuCriteria,omitempty"`
+
+	// The current recommended standard version for the NP Software Track.
+	TrackStandardSwVersion *string `json:"trackStandardSwVersion,omitempty"`
+
+	// The status of the code deployment, as defined in the NP Software Track.  Values include Fully Deployed, In Migration, etc.
+	TrackStatus *string `json:"trackStatus,omitempty"`
+
+	// Total number of devices in the NP Software Track.
+	TrackTotalDevices *int `json:"trackTotalDevices,omitempty"`
+
+	// Total number of unique software versions in the NP Software Track.
+	TrackTotalSwVersions *int `json:"trackTotalSwVersions,omitempty"`
+
+	// The reason for the last change in software recommendation, as defined in the NP Software Track.  Values include New Software Implementation, Planned Maintenance, etc.
+	TrackUpgradeReason *string `json:"trackUpgradeReason,omitempty"`
+}
@@ -0,0 +1,183 @@
+package ciscobcs
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{`"2020-09-24 16:00:00"`, time.Date(2020, 9, 24, 16, 0, 0, 0, time.UTC)},
+		{`"2020-09-24T16:00:00"`, time.Date(2020, 9, 24, 16, 0, 0, 0, time.UTC)},
+		{`"2020-09-24"`, time.Date(2020, 9, 24, 0, 0, 0, 0, time.UTC)},
+		{`"2020-09-24T16:00:00Z"`, time.Date(2020, 9, 24, 16, 0, 0, 0, time.UTC)},
+		{`"2020-09-24T17:00:00+01:00"`, time.Date(2020, 9, 24, 16, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		var got Timestamp
+		if err := json.Unmarshal([]byte(tc.input), &got); err != nil {
+			t.Errorf("%s: didn't expect error: %v", tc.input, err)
+			continue
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("%s: got %v; want %v", tc.input, got.Time, tc.want)
+		}
+		b, err := json.Marshal(got)
+		if err != nil {
+			t.Errorf("%s: didn't expect error: %v", tc.input, err)
+		}
+		if string(b) != tc.input {
+			t.Errorf("got %s; want %s", b, tc.input)
+		}
+	}
+	var ts Timestamp
+	if err := json.Unmarshal([]byte(`"24/09/2020"`), &ts); err == nil {
+		t.Errorf("expected error for unrecognised format")
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	t.Run("empty and null values", func(t *testing.T) {
+		for _, input := range []string{`""`, `null`, `"None"`} {
+			var d struct {
+				Date      Date      `json:"date"`
+				DateTime  DateTime  `json:"dateTime"`
+				Timestamp Timestamp `json:"timestamp"`
+			}
+			data := `{"date":` + input + `,"dateTime":` + input + `,"timestamp":` + input + `}`
+			if err := json.Unmarshal([]byte(data), &d); err != nil {
+				t.Errorf("%s: didn't expect error: %v", input, err)
+				continue
+			}
+			if !d.Date.IsZero() || !d.DateTime.IsZero() || !d.Timestamp.IsZero() {
+				t.Errorf("%s: expected zero values, got %+v", input, d)
+			}
+			b, err := json.Marshal(d)
+			if err != nil {
+				t.Errorf("%s: didn't expect error: %v", input, err)
+			}
+			if want := `{"date":"","dateTime":"","timestamp":""}`; string(b) != want {
+				t.Errorf("got %s; want %s", b, want)
+			}
+		}
+	})
+	t.Run("location", func(t *testing.T) {
+		loc := time.FixedZone("EST", -5*60*60)
+		defer func(l *time.Location) { Location = l }(Location)
+		Location = loc
+		var d DateTime
+		if err := json.Unmarshal([]byte(`"2021-07-29T20:03:03"`), &d); err != nil {
+			t.Fatalf("didn't expect error: %v", err)
+		}
+		if want := time.Date(2021, 7, 29, 20, 3, 3, 0, loc); !d.Equal(want) {
+			t.Errorf("got %v; want %v", d.Time, want)
+		}
+		b, _ := json.Marshal(d)
+		if want := `"2021-07-29T20:03:03"`; string(b) != want {
+			t.Errorf("got %s; want %s", b, want)
+		}
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	file, err := os.ReadFile("../../demo_bcs_bulk.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := scanBulk(strings.NewReader(string(file)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results.Normalize()
+	missing := 0
+	for _, d := range results.Devices {
+		if d.SwType == nil {
+			missing++
+		}
+		if d.UserField1 != nil {
+			t.Errorf("got userField1 %q; want nil", *d.UserField1)
+		}
+		if d.DeviceName == nil {
+			t.Errorf("expected device name to be retained")
+		}
+		if d.GetSwType() == "Missing" {
+			t.Errorf("got Missing swType after normalizing")
+		}
+	}
+	if missing != 141 {
+		t.Errorf("got %v devices without swType; want %v", missing, 141)
+	}
+	d := Device{SwVersion: String(" Not Found "), ConfigTime: &DateTime{}, DeviceId: Int(1)}
+	Normalize(&d)
+	if d.SwVersion != nil || d.ConfigTime != nil || d.DeviceId == nil {
+		t.Errorf("unexpected result normalizing device: %+v", d)
+	}
+}
+
+// modelTypes holds an example of each of the model types, for tests which apply to them all.
+var modelTypes = []interface{}{
+	Asset{},
+	CBPDetails{},
+	CBPRule{},
+	CBPRuleReference{},
+	CBPSummary{},
+	Collector{},
+	ContractBasePID{},
+	CrashRisk{},
+	Device{},
+	ErrorResponse{},
+	FNBulletin{},
+	Feedback{},
+	FieldNotice{},
+	HWEOX{},
+	HWEOXBulletin{},
+	ItemCount{},
+	OrderablePid{},
+	PSIRTBulletin{},
+	Page{},
+	SWEOXBulletin{},
+	SecurityAdvisory{},
+	SerialNumberDetails{},
+	SoftwareAlert{},
+	SoftwareEOX{},
+	TrackCompliance{},
+	TrackSmupieRecommendation{},
+	TrackSummary{},
+}
+
+func TestAccessors(t *testing.T) {
+	for _, m := range modelTypes {
+		typ := reflect.TypeOf(m)
+		ptr := reflect.PtrTo(typ)
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if f.Type.Kind() != reflect.Ptr {
+				continue
+			}
+			method, ok := ptr.MethodByName("Get" + f.Name)
+			if !ok {
+				t.Errorf("%v.%v: missing getter; run go generate", typ.Name(), f.Name)
+				continue
+			}
+			// a nil receiver returns the zero value
+			nilResult := method.Func.Call([]reflect.Value{reflect.Zero(ptr)})[0]
+			if !nilResult.IsZero() {
+				t.Errorf("%v.Get%v: got %v for nil receiver; want zero value", typ.Name(), f.Name, nilResult)
+			}
+			// a populated field returns its value
+			v := reflect.New(typ)
+			fv := reflect.New(f.Type.Elem())
+			v.Elem().Field(i).Set(fv)
+			if got := method.Func.Call([]reflect.Value{v})[0]; !reflect.DeepEqual(got.Interface(), fv.Elem().Interface()) {
+				t.Errorf("%v.Get%v: got %v; want %v", typ.Name(), f.Name, got, fv.Elem())
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package ciscobcs
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Sentinels holds the placeholder values used in the Cisco results where a value is absent or
+// unknown, e.g. a swType of "Missing" or a swVersion of "Not Found".  Normalize replaces string
+// fields holding any of these values, ignoring surrounding whitespace, with nil.
+var Sentinels = []string{"", "Missing", "Not Found", "null"}
+
+// IsSentinel reports whether s is one of the Sentinels.
+func IsSentinel(s string) bool {
+	s = strings.TrimSpace(s)
+	for _, sentinel := range Sentinels {
+		if s == sentinel {
+			return true
+		}
+	}
+	return false
+}
+
+// Normalize replaces the placeholder values in v with nil pointers, so that absent and unknown
+// values can be distinguished from real ones.  String fields holding one of the Sentinels and
+// empty dates are set to nil.  v must be a pointer to one of the model types, a slice of them,
+// or a BulkResults, and is modified in place.
+func Normalize(v interface{}) {
+	normalizeValue(reflect.ValueOf(v))
+}
+
+// Normalize replaces the placeholder values in each of the records in the results with nil
+// pointers.  See the Normalize function for details.
+func (r *BulkResults) Normalize() {
+	Normalize(r)
+}
+
+// zeroer is implemented by the date types, which are empty when a blank value was provided.
+type zeroer interface {
+	IsZero() bool
+}
+
+var zeroerType = reflect.TypeOf((*zeroer)(nil)).Elem()
+
+// normalizeValue walks v, replacing sentinel strings and empty dates with nil.
+func normalizeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			normalizeValue(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			normalizeValue(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if !f.CanSet() {
+				continue
+			}
+			if f.Kind() == reflect.Ptr && !f.IsNil() {
+				switch {
+				case f.Elem().Kind() == reflect.String:
+					if IsSentinel(f.Elem().String()) {
+						f.Set(reflect.Zero(f.Type()))
+					}
+					continue
+				case f.Type().Implements(zeroerType):
+					if f.Interface().(zeroer).IsZero() {
+						f.Set(reflect.Zero(f.Type()))
+					}
+					continue
+				}
+			}
+			normalizeValue(f)
+		}
+	}
+}
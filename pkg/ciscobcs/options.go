@@ -0,0 +1,97 @@
+package ciscobcs
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// QueryOptions specifies the optional parameters to the service methods that support filtering
+// and masking of the results.
+type QueryOptions struct {
+	// Filter is a query filter in JSON to search for specific fields, e.g. {"productType":"LAN Switches"}.
+	Filter string `url:"filter,omitempty"`
+
+	// Mask limits the fields returned, e.g. ItemsMask("deviceName", "productId") for
+	// items{deviceName,productId},page,pages,total.
+	Mask Mask `url:"mask,omitempty"`
+}
+
+// ListOptions specifies the optional parameters to the service methods that support filtering,
+// masking and pagination of the results.
+type ListOptions struct {
+	// Filter is a query filter in JSON to search for specific fields, e.g. {"productType":"LAN Switches"}.
+	Filter string `url:"filter,omitempty"`
+
+	// Mask limits the fields returned, e.g. ItemsMask("deviceName", "productId") for
+	// items{deviceName,productId},page,pages,total.
+	Mask Mask `url:"mask,omitempty"`
+
+	// Page of results to retrieve.  Defaults to 1.
+	Page int `url:"page,omitempty"`
+
+	// PerPage is the number of results to include per page.  Defaults to 500.
+	PerPage int `url:"perPage,omitempty"`
+}
+
+// addOptions adds the parameters in opts as URL query parameters to s.  opts must be a pointer to
+// a struct whose fields are tagged with the parameter names in the same way as ListOptions.
+func addOptions(s string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+	qs := u.Query()
+	if err := addValues(qs, reflect.Indirect(v)); err != nil {
+		return s, err
+	}
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// addValues adds each of the tagged fields in the struct v to qs, including those of embedded structs.
+func addValues(qs url.Values, v reflect.Value) error {
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("ciscobcs: options must be a struct, not %v", v.Kind())
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf, f := t.Field(i), v.Field(i)
+		if sf.Anonymous && f.Kind() == reflect.Struct {
+			if err := addValues(qs, f); err != nil {
+				return err
+			}
+			continue
+		}
+		tag := sf.Tag.Get("url")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		omitEmpty := strings.Contains(tag, ",omitempty")
+		if omitEmpty && (f.IsZero() || f.Kind() == reflect.Slice && f.Len() == 0) {
+			continue
+		}
+		if s, ok := f.Interface().(fmt.Stringer); ok {
+			qs.Set(name, s.String())
+			continue
+		}
+		switch f.Kind() {
+		case reflect.String:
+			qs.Set(name, f.String())
+		case reflect.Int, reflect.Int64:
+			qs.Set(name, strconv.FormatInt(f.Int(), 10))
+		case reflect.Bool:
+			qs.Set(name, strconv.FormatBool(f.Bool()))
+		default:
+			return fmt.Errorf("ciscobcs: unsupported option type %v for %s", f.Kind(), sf.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package ciscobcs
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	cvePattern   = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+	bugIDPattern = regexp.MustCompile(`^CSC[a-z]{2}\d{5}$`)
+)
+
+// SeverityImpactRating represents the Security Impact Rating (SIR) of a PSIRT bulletin.
+// Ratings are ordered from least to most severe, so they can be compared directly.
+type SeverityImpactRating int
+
+// Security Impact Ratings in order of severity.
+const (
+	SeverityUnknown SeverityImpactRating = iota
+	SeverityInformational
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+var severityNames = map[SeverityImpactRating]string{
+	SeverityUnknown:       "Unknown",
+	SeverityInformational: "Informational",
+	SeverityLow:           "Low",
+	SeverityMedium:        "Medium",
+	SeverityHigh:          "High",
+	SeverityCritical:      "Critical",
+}
+
+// ParseSeverityImpactRating returns the rating for the given SIR value, e.g. "High".
+// Unrecognised values return SeverityUnknown.
+func ParseSeverityImpactRating(s string) SeverityImpactRating {
+	s = strings.TrimSpace(s)
+	for k, v := range severityNames {
+		if strings.EqualFold(s, v) {
+			return k
+		}
+	}
+	return SeverityUnknown
+}
+
+// String returns the SIR value as used in the Cisco results.
+func (s SeverityImpactRating) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return severityNames[SeverityUnknown]
+}
+
+// MarshalText will marshal the rating using its name, e.g. "High".
+func (s SeverityImpactRating) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText will unmarshal the rating from its name, e.g. "High".
+func (s *SeverityImpactRating) UnmarshalText(text []byte) error {
+	*s = ParseSeverityImpactRating(string(text))
+	return nil
+}
+
+// AtLeast reports whether the rating is at least as severe as o.
+func (s SeverityImpactRating) AtLeast(o SeverityImpactRating) bool {
+	return s >= o
+}
+
+// Severity returns the typed Security Impact Rating for the bulletin.
+func (p *PSIRTBulletin) Severity() SeverityImpactRating {
+	if p.Sir == nil {
+		return SeverityUnknown
+	}
+	return ParseSeverityImpactRating(*p.Sir)
+}
+
+// CvssBaseScore returns the CVSS base score for the bulletin.  The boolean is false
+// where the score is missing or cannot be parsed.
+func (p *PSIRTBulletin) CvssBaseScore() (float64, bool) {
+	return parseCvss(p.CvssBase)
+}
+
+// CvssTemporalScore returns the CVSS temporal score for the bulletin.  The boolean is false
+// where the score is missing or cannot be parsed, which is common for this field.
+func (p *PSIRTBulletin) CvssTemporalScore() (float64, bool) {
+	return parseCvss(p.CvssTemporal)
+}
+
+// CVEs returns the individual CVE identifiers from the comma-separated CveId field.  Values
+// that are not valid CVE identifiers, such as "CVE-2014-TBD", are ignored.
+func (p *PSIRTBulletin) CVEs() []string {
+	return splitIDs(p.CveId, func(id string) (string, bool) {
+		id = strings.ToUpper(id)
+		return id, cvePattern.MatchString(id)
+	})
+}
+
+// BugIDs returns the individual Cisco bug IDs from the comma-separated CiscoBugIds field.
+// Values that are not valid bug IDs of the form CSCxx12345 are ignored.
+func (p *PSIRTBulletin) BugIDs() []string {
+	return splitIDs(p.CiscoBugIds, func(id string) (string, bool) {
+		return id, bugIDPattern.MatchString(id)
+	})
+}
+
+// CVEIndex maps CVE identifiers to the PSIRT bulletins that reference them.
+type CVEIndex map[string][]PSIRTBulletin
+
+// IndexCVEs returns an index of the PSIRT bulletins in the results by CVE identifier.
+func (r *BulkResults) IndexCVEs() CVEIndex {
+	idx := make(CVEIndex)
+	for _, b := range r.PSIRTBulletins {
+		for _, cve := range b.CVEs() {
+			idx[cve] = append(idx[cve], b)
+		}
+	}
+	return idx
+}
+
+// Lookup returns the PSIRT bulletins that reference the given CVE identifier, if any.
+func (idx CVEIndex) Lookup(cve string) []PSIRTBulletin {
+	return idx[strings.ToUpper(strings.TrimSpace(cve))]
+}
+
+// PSIRTBulletinsForCVE returns the PSIRT bulletins in the results that reference the given CVE
+// identifier.  An empty result means none of the bulletins for the customer reference it.  Use
+// IndexCVEs instead where many CVEs are to be looked up.
+func (r *BulkResults) PSIRTBulletinsForCVE(cve string) []PSIRTBulletin {
+	cve = strings.ToUpper(strings.TrimSpace(cve))
+	var bulletins []PSIRTBulletin
+	for _, b := range r.PSIRTBulletins {
+		for _, c := range b.CVEs() {
+			if c == cve {
+				bulletins = append(bulletins, b)
+				break
+			}
+		}
+	}
+	return bulletins
+}
+
+// SortPSIRTBulletins sorts the bulletins with the most severe first, by Security Impact Rating
+// and then CVSS base score.
+func SortPSIRTBulletins(bulletins []PSIRTBulletin) {
+	sort.SliceStable(bulletins, func(i, j int) bool {
+		si, sj := bulletins[i].Severity(), bulletins[j].Severity()
+		if si != sj {
+			return si > sj
+		}
+		ci, _ := bulletins[i].CvssBaseScore()
+		cj, _ := bulletins[j].CvssBaseScore()
+		return ci > cj
+	})
+}
+
+// parseCvss parses a CVSS score, which is provided as a string, e.g. "7.8".
+func parseCvss(score *string) (float64, bool) {
+	if score == nil {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(*score), 64)
+	if err != nil || f < 0 || f > 10 {
+		return 0, false
+	}
+	return f, true
+}
+
+// splitIDs splits a comma-separated list of identifiers, returning those the valid
+// function accepts, in the form it returns them.  Duplicates are removed.
+func splitIDs(list *string, valid func(string) (string, bool)) []string {
+	if list == nil {
+		return nil
+	}
+	var ids []string
+	seen := make(map[string]bool)
+	for _, id := range strings.Split(*list, ",") {
+		id, ok := valid(strings.TrimSpace(id))
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,79 @@
+package ciscobcs
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPSIRTBulletin(t *testing.T) {
+	t.Run("severity and cvss", func(t *testing.T) {
+		bulletins := []PSIRTBulletin{
+			{PsirtAdvisoryId: String("a"), Sir: String("Medium"), CvssBase: String("5.3")},
+			{PsirtAdvisoryId: String("b"), Sir: String("High"), CvssBase: String("7.8"), CvssTemporal: String("")},
+			{PsirtAdvisoryId: String("c"), Sir: String(""), CvssBase: String("bad")},
+			{PsirtAdvisoryId: String("d"), Sir: String("critical"), CvssBase: String("10.0"), CvssTemporal: String("9.5")},
+			{PsirtAdvisoryId: String("e"), Sir: String("High"), CvssBase: String("8.6")},
+		}
+		if got := bulletins[3].Severity(); got != SeverityCritical {
+			t.Errorf("got %v; want %v", got, SeverityCritical)
+		}
+		if got := bulletins[2].Severity(); got != SeverityUnknown {
+			t.Errorf("got %v; want %v", got, SeverityUnknown)
+		}
+		if !bulletins[1].Severity().AtLeast(SeverityHigh) || bulletins[0].Severity().AtLeast(SeverityHigh) {
+			t.Errorf("unexpected AtLeast(SeverityHigh) result")
+		}
+		if got, ok := bulletins[1].CvssBaseScore(); !ok || got != 7.8 {
+			t.Errorf("got %v, %v; want 7.8, true", got, ok)
+		}
+		if _, ok := bulletins[1].CvssTemporalScore(); ok {
+			t.Errorf("expected no temporal score for empty value")
+		}
+		if _, ok := bulletins[2].CvssBaseScore(); ok {
+			t.Errorf("expected no base score for invalid value")
+		}
+		SortPSIRTBulletins(bulletins)
+		got := ""
+		for _, b := range bulletins {
+			got += *b.PsirtAdvisoryId
+		}
+		if want := "debac"; got != want {
+			t.Errorf("got order %v; want %v", got, want)
+		}
+	})
+	t.Run("cve and bug ids", func(t *testing.T) {
+		b := PSIRTBulletin{
+			CveId:       String("CVE-2015-0639, cve-2015-0640,CVE-2014-TBD,,CVE-2015-0639"),
+			CiscoBugIds: String("CSCum36951,SCvx82861,CSCuo75572"),
+		}
+		if got, want := b.CVEs(), []string{"CVE-2015-0639", "CVE-2015-0640"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v; want %v", got, want)
+		}
+		if got, want := b.BugIDs(), []string{"CSCum36951", "CSCuo75572"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v; want %v", got, want)
+		}
+	})
+	t.Run("cve index", func(t *testing.T) {
+		file, err := os.ReadFile("../../demo_bcs_bulk.jsonl")
+		if err != nil {
+			t.Fatal(err)
+		}
+		results, err := scanBulk(strings.NewReader(string(file)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		idx := results.IndexCVEs()
+		got := idx.Lookup("cve-2015-0639")
+		if len(got) != 5 {
+			t.Errorf("got %v bulletins; want %v", len(got), 5)
+		}
+		if want := results.PSIRTBulletinsForCVE("CVE-2015-0639"); !reflect.DeepEqual(got, want) {
+			t.Errorf("index lookup differs from direct search")
+		}
+		if got := idx.Lookup("CVE-1999-0001"); len(got) != 0 {
+			t.Errorf("got %v bulletins; want none", len(got))
+		}
+	})
+}
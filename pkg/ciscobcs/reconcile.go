@@ -0,0 +1,245 @@
+package ciscobcs
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// reconcileIgnoredFields holds the device fields which are expected to differ between the
+// records for the same chassis, so are not reported as conflicts.
+var reconcileIgnoredFields = map[string]bool{
+	"deviceId":      true,
+	"createDate":    true,
+	"inventoryTime": true,
+	"configTime":    true,
+}
+
+// DeviceKey identifies a chassis for the purposes of reconciling duplicate device records.
+// Records are matched on the SysName and ProductId, or on the IpAddress and ProductId, so a
+// key used for matching has only one of SysName and IpAddress set.
+type DeviceKey struct {
+	SysName   string
+	IpAddress string
+	ProductId string
+}
+
+// String returns the key as a single value, e.g. for use in a report.
+func (k DeviceKey) String() string {
+	return k.SysName + "/" + k.IpAddress + "/" + k.ProductId
+}
+
+// DeviceGroup holds the device records which probably refer to the same chassis.
+type DeviceGroup struct {
+	// Key is the identity of the freshest device in the group.  The other devices share either
+	// its SysName or its IpAddress, or are linked to it through another device in the group.
+	Key DeviceKey
+
+	// Devices holds the records in the group, freshest first.
+	Devices []Device
+
+	// Conflicts holds the fields for which the records in the group disagree.
+	Conflicts []DeviceConflict
+}
+
+// Freshest returns the most recently collected device in the group, which is used in place
+// of the others once reconciled.
+func (g DeviceGroup) Freshest() *Device {
+	return &g.Devices[0]
+}
+
+// DeviceConflict describes a field for which duplicate device records disagree.
+type DeviceConflict struct {
+	// Field is the json name of the field, e.g. swVersion.
+	Field string
+
+	// Values holds the value of the field for each of the devices in the group, in the same
+	// order, with an empty string for a missing value.
+	Values []string
+}
+
+// DeviceReconciliation holds the results of reconciling duplicate device records.
+type DeviceReconciliation struct {
+	// Devices holds the reconciled devices, i.e. the freshest record from each group of
+	// duplicates along with every device which has no duplicate, in their original order.
+	Devices []Device
+
+	// Groups holds each group of duplicate devices, ordered by key.
+	Groups []DeviceGroup
+
+	// Duplicates is the number of device records removed by reconciliation.
+	Duplicates int
+}
+
+// ReconcileDevices groups the probable duplicates in devices and picks the freshest of each.
+// Network Profile creates a new device record whenever a unique name and sysObjectID is seen
+// by the collector, so the same chassis can appear more than once with different DeviceIds.
+//
+// Records with the same DeviceId are always duplicates.  Otherwise devices are probable
+// duplicates when they have the same ProductId along with either the same DeviceSysName,
+// ignoring case, or the same IpAddress, so that a chassis is still matched after it has been
+// renamed or readdressed.  Matches are transitive, so a group can hold records which share
+// neither.  Devices without a ProductId, or with neither a DeviceSysName nor an IpAddress,
+// cannot be identified, so are only treated as duplicates of records with the same DeviceId.  The freshest is the device
+// with the latest InventoryTime, then ConfigTime, and then the highest DeviceId.
+func ReconcileDevices(devices []Device) *DeviceReconciliation {
+	parent := make([]int, len(devices))
+	for i := range parent {
+		parent[i] = i
+	}
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	union := func(i, j int) {
+		if ri, rj := find(i), find(j); ri != rj {
+			parent[rj] = ri
+		}
+	}
+
+	ids := make(map[int]int)
+	keys := make(map[DeviceKey]int)
+	for i := range devices {
+		if id := devices[i].GetDeviceId(); id != 0 {
+			if j, ok := ids[id]; ok {
+				union(j, i)
+			} else {
+				ids[id] = i
+			}
+		}
+		key, ok := deviceKey(&devices[i])
+		if !ok {
+			continue
+		}
+		for _, k := range []DeviceKey{
+			{SysName: key.SysName, ProductId: key.ProductId},
+			{IpAddress: key.IpAddress, ProductId: key.ProductId},
+		} {
+			if k.SysName == "" && k.IpAddress == "" {
+				continue
+			}
+			if j, ok := keys[k]; ok {
+				union(j, i)
+			} else {
+				keys[k] = i
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i := range devices {
+		root := find(i)
+		groups[root] = append(groups[root], i)
+	}
+
+	r := &DeviceReconciliation{}
+	drop := make(map[int]bool)
+	for _, indexes := range groups {
+		if len(indexes) == 1 {
+			continue
+		}
+		sort.SliceStable(indexes, func(i, j int) bool {
+			return fresher(&devices[indexes[i]], &devices[indexes[j]])
+		})
+		var g DeviceGroup
+		g.Key, _ = deviceKey(&devices[indexes[0]])
+		for _, i := range indexes {
+			g.Devices = append(g.Devices, devices[i])
+		}
+		for _, i := range indexes[1:] {
+			drop[i] = true
+		}
+		g.Conflicts = deviceConflicts(g.Devices)
+		r.Groups = append(r.Groups, g)
+		r.Duplicates += len(indexes) - 1
+	}
+	sort.Slice(r.Groups, func(i, j int) bool {
+		if ki, kj := r.Groups[i].Key.String(), r.Groups[j].Key.String(); ki != kj {
+			return ki < kj
+		}
+		return r.Groups[i].Freshest().GetDeviceId() < r.Groups[j].Freshest().GetDeviceId()
+	})
+
+	for i, d := range devices {
+		if !drop[i] {
+			r.Devices = append(r.Devices, d)
+		}
+	}
+	return r
+}
+
+// ReconcileDevices groups the probable duplicates in the devices in the results and picks
+// the freshest of each.  See the ReconcileDevices function for details.
+func (r *BulkResults) ReconcileDevices() *DeviceReconciliation {
+	return ReconcileDevices(r.Devices)
+}
+
+// deviceKey returns the key for the device, which is only valid when the device has a
+// ProductId and either a DeviceSysName or an IpAddress.
+func deviceKey(d *Device) (DeviceKey, bool) {
+	key := DeviceKey{
+		SysName:   strings.ToLower(strings.TrimSpace(d.GetDeviceSysName())),
+		IpAddress: strings.TrimSpace(d.GetIpAddress()),
+		ProductId: strings.TrimSpace(d.GetProductId()),
+	}
+	if IsSentinel(key.SysName) {
+		key.SysName = ""
+	}
+	if IsSentinel(key.IpAddress) {
+		key.IpAddress = ""
+	}
+	if IsSentinel(key.ProductId) {
+		key.ProductId = ""
+	}
+	return key, key.ProductId != "" && (key.SysName != "" || key.IpAddress != "")
+}
+
+// fresher reports whether device a was collected more recently than device b.
+func fresher(a, b *Device) bool {
+	if ta, tb := a.GetInventoryTime().Time, b.GetInventoryTime().Time; !ta.Equal(tb) {
+		return ta.After(tb)
+	}
+	if ta, tb := a.GetConfigTime().Time, b.GetConfigTime().Time; !ta.Equal(tb) {
+		return ta.After(tb)
+	}
+	return a.GetDeviceId() > b.GetDeviceId()
+}
+
+// deviceConflicts returns the fields for which the devices disagree, in field order.
+func deviceConflicts(devices []Device) []DeviceConflict {
+	var conflicts []DeviceConflict
+	typ := reflect.TypeOf(Device{})
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" || reconcileIgnoredFields[name] {
+			continue
+		}
+		values := make([]string, len(devices))
+		for j := range devices {
+			values[j] = fieldString(reflect.ValueOf(devices[j]).Field(i))
+		}
+		for _, v := range values[1:] {
+			if v != values[0] {
+				conflicts = append(conflicts, DeviceConflict{Field: name, Values: values})
+				break
+			}
+		}
+	}
+	return conflicts
+}
+
+// fieldString returns the value of a model field as a string, or an empty string if it is nil.
+func fieldString(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,79 @@
+package ciscobcs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReconcileDevices(t *testing.T) {
+	dt := func(s string) *DateTime {
+		tm, err := time.Parse(DateTimeMinusTimezoneFormat, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &DateTime{tm}
+	}
+	devices := []Device{
+		{DeviceId: Int(1), DeviceName: String("core1"), DeviceSysName: String("Core1.example.com"), IpAddress: String("10.0.0.1"), ProductId: String("C9500"), SwVersion: String("16.9.4"), InventoryTime: dt("2021-08-01T09:00:00")},
+		{DeviceId: Int(2), DeviceName: String("edge1"), DeviceSysName: String("edge1.example.com"), IpAddress: String("10.0.0.2"), ProductId: String("ISR4451")},
+		{DeviceId: Int(3), DeviceName: String("10.0.0.1"), DeviceSysName: String("core1.example.com"), IpAddress: String("10.0.0.1"), ProductId: String("C9500"), SwVersion: String("16.12.3"), InventoryTime: dt("2021-08-02T09:00:00")},
+		{DeviceId: Int(4), DeviceName: String("172.21.137.4"), DeviceSysName: String(""), IpAddress: String("")},
+		{DeviceId: Int(5), DeviceName: String("172.21.142.4"), DeviceSysName: String(""), IpAddress: String("")},
+		{DeviceId: Int(6), DeviceName: String("core2"), DeviceSysName: String("core2"), IpAddress: String("10.0.0.6"), ProductId: String("C9300"), InventoryTime: dt("2021-08-01T09:00:00")},
+		{DeviceId: Int(7), DeviceName: String("core2"), DeviceSysName: String("core2"), IpAddress: String("10.0.0.7"), ProductId: String("C9300"), InventoryTime: dt("2021-08-03T09:00:00")},
+		{DeviceId: Int(8), DeviceName: String("core2"), DeviceSysName: String("core2-new"), IpAddress: String("10.0.0.7"), ProductId: String("C9300"), InventoryTime: dt("2021-08-05T09:00:00")},
+		{DeviceId: Int(9), DeviceName: String("edge1"), DeviceSysName: String("edge1.example.com"), IpAddress: String("10.0.0.2"), ProductId: String("C1111")},
+		{DeviceId: Int(4), DeviceName: String("172.21.137.4"), DeviceSysName: String(""), IpAddress: String("")},
+		{DeviceId: Int(10), DeviceName: String("ap1"), DeviceSysName: String("ap"), IpAddress: String("10.0.1.1"), ProductId: String("Missing")},
+		{DeviceId: Int(11), DeviceName: String("ap2"), DeviceSysName: String("ap"), IpAddress: String("10.0.1.2")},
+	}
+	r := ReconcileDevices(devices)
+	if r.Duplicates != 4 || len(r.Groups) != 3 {
+		t.Fatalf("got %v duplicates in %v groups; want 4 in 3", r.Duplicates, len(r.Groups))
+	}
+	var ids []int
+	for _, d := range r.Devices {
+		ids = append(ids, d.GetDeviceId())
+	}
+	if want := []int{2, 3, 4, 5, 8, 9, 10, 11}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got devices %v; want %v", ids, want)
+	}
+	if g := r.Groups[0]; len(g.Devices) != 2 || g.Freshest().GetDeviceId() != 4 || g.Conflicts != nil {
+		t.Errorf("got %+v; want the records with DeviceId 4", g)
+	}
+	g := r.Groups[1]
+	if g.Freshest().GetDeviceId() != 3 {
+		t.Errorf("got freshest %v; want 3", g.Freshest().GetDeviceId())
+	}
+	want := []DeviceConflict{
+		{Field: "deviceName", Values: []string{"10.0.0.1", "core1"}},
+		{Field: "deviceSysName", Values: []string{"core1.example.com", "Core1.example.com"}},
+		{Field: "swVersion", Values: []string{"16.12.3", "16.9.4"}},
+	}
+	if !reflect.DeepEqual(g.Conflicts, want) {
+		t.Errorf("got conflicts %v; want %v", g.Conflicts, want)
+	}
+	g = r.Groups[2]
+	ids = nil
+	for _, d := range g.Devices {
+		ids = append(ids, d.GetDeviceId())
+	}
+	if want := []int{8, 7, 6}; !reflect.DeepEqual(ids, want) || g.Key.String() != "core2-new/10.0.0.7/C9300" {
+		t.Errorf("got %v devices %v; want %v renamed and readdressed", g.Key, ids, want)
+	}
+	want = []DeviceConflict{
+		{Field: "deviceSysName", Values: []string{"core2-new", "core2", "core2"}},
+		{Field: "ipAddress", Values: []string{"10.0.0.7", "10.0.0.7", "10.0.0.6"}},
+	}
+	if !reflect.DeepEqual(g.Conflicts, want) {
+		t.Errorf("got conflicts %v; want %v", g.Conflicts, want)
+	}
+
+	t.Run("demo", func(t *testing.T) {
+		r := demoResults(t).ReconcileDevices()
+		if len(r.Devices)+r.Duplicates != 300 {
+			t.Errorf("got %v devices and %v duplicates; want 300 in total", len(r.Devices), r.Duplicates)
+		}
+	})
+}
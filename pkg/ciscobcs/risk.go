@@ -0,0 +1,525 @@
+package ciscobcs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Names of the risk factors provided by the package, used as the keys of RiskWeights.
+const (
+	RiskFactorPSIRT       = "psirt"
+	RiskFactorFieldNotice = "field-notice"
+	RiskFactorEoX         = "eox"
+	RiskFactorCrashRisk   = "crash-risk"
+	RiskFactorUnreachable = "unreachable"
+)
+
+// RiskWeights holds the weight of each risk factor, keyed by the name of the factor.
+type RiskWeights map[string]float64
+
+// DefaultRiskWeights holds the weights used for the risk factors provided by the package where
+// no other weight is given.  They add up to 100, so that risk scores are out of 100.
+var DefaultRiskWeights = RiskWeights{
+	RiskFactorPSIRT:       40,
+	RiskFactorFieldNotice: 15,
+	RiskFactorEoX:         20,
+	RiskFactorCrashRisk:   15,
+	RiskFactorUnreachable: 10,
+}
+
+// RiskFactor scores a single aspect of the risk of a device, such as its PSIRT exposure.
+type RiskFactor interface {
+	// Name identifies the factor, e.g. psirt, and is used to look up its weight.
+	Name() string
+
+	// Score returns the risk to the device from 0, for none, to 1, for the highest, along with
+	// an explanation of each reason for a non-zero score, most significant first.
+	Score(d *Device) (float64, []string)
+}
+
+// RiskFactorBuilder returns a RiskFactor for the given inputs.  Factors are built once for each
+// set of inputs, so they can index the inputs before scoring each device.
+type RiskFactorBuilder func(in *RiskInputs) RiskFactor
+
+// DefaultRiskFactors holds the builders for the risk factors provided by the package.
+var DefaultRiskFactors = []RiskFactorBuilder{
+	NewPSIRTRiskFactor,
+	NewFieldNoticeRiskFactor,
+	NewEoXRiskFactor,
+	NewCrashRiskFactor,
+	NewUnreachableRiskFactor,
+}
+
+// RiskInputs holds the data used to score the risk of devices.  Whatever is available is used,
+// so factors without the data they need simply score zero.  The bulk data provides everything
+// other than the CrashRisks, which can be added from the API using Enrich.
+type RiskInputs struct {
+	Devices            []Device
+	PSIRTBulletins     []PSIRTBulletin
+	SecurityAdvisories []SecurityAdvisory
+	FNBulletins        []FNBulletin
+	FieldNotices       []FieldNotice
+	HWEoxBulletins     []HWEOXBulletin
+	SWEoxBulletins     []SWEOXBulletin
+	CrashRisks         []CrashRisk
+
+	// Ref is the date against which EoX milestones are assessed, typically the current date.
+	Ref time.Time
+}
+
+// RiskInputs returns the inputs for scoring the risk of the devices in the results, assessing
+// EoX milestones against ref.
+func (r *BulkResults) RiskInputs(ref time.Time) *RiskInputs {
+	return &RiskInputs{
+		Devices:            r.Devices,
+		PSIRTBulletins:     r.PSIRTBulletins,
+		SecurityAdvisories: r.SecurityAdvisories,
+		FNBulletins:        r.FNBulletins,
+		FieldNotices:       r.FieldNotices,
+		HWEoxBulletins:     r.HWEoxBulletins,
+		SWEoxBulletins:     r.SWEoxBulletins,
+		Ref:                ref,
+	}
+}
+
+// Enrich retrieves the crash risks for the customer's devices using the API, since these are
+// not included in the bulk data, and adds them to the inputs.  The security advisories and
+// field notices are also retrieved where there are none.  Each list is only added once it has
+// been retrieved in full, and is skipped where the inputs already have it, so Enrich can be
+// called again after an error.
+func (in *RiskInputs) Enrich(ctx context.Context, c *Client, customerID string) error {
+	if len(in.FieldNotices) == 0 {
+		var notices []FieldNotice
+		err := listAll(func(opts *ListOptions) (*Page, error) {
+			p, err := c.ProductAlertService.ListFieldNotices(ctx, customerID, opts)
+			if err != nil {
+				return nil, err
+			}
+			notices = append(notices, p.Items...)
+			return &p.Page, nil
+		})
+		if err != nil {
+			return err
+		}
+		in.FieldNotices = notices
+	}
+	if len(in.CrashRisks) == 0 {
+		var risks []CrashRisk
+		err := listAll(func(opts *ListOptions) (*Page, error) {
+			p, err := c.CrashPreventionService.ListCrashRisks(ctx, customerID, opts)
+			if err != nil {
+				return nil, err
+			}
+			risks = append(risks, p.Items...)
+			return &p.Page, nil
+		})
+		if err != nil {
+			return err
+		}
+		in.CrashRisks = risks
+	}
+	if len(in.SecurityAdvisories) == 0 {
+		var advisories []SecurityAdvisory
+		err := listAll(func(opts *ListOptions) (*Page, error) {
+			p, err := c.ProductAlertService.ListSecurityAdvisories(ctx, customerID, opts)
+			if err != nil {
+				return nil, err
+			}
+			advisories = append(advisories, p.Items...)
+			return &p.Page, nil
+		})
+		if err != nil {
+			return err
+		}
+		in.SecurityAdvisories = advisories
+	}
+	return nil
+}
+
+// listAll calls list for each page of results in turn until the last page has been retrieved.
+func listAll(list func(opts *ListOptions) (*Page, error)) error {
+	for page := 1; ; page++ {
+		p, err := list(&ListOptions{Page: page})
+		if err != nil {
+			return err
+		}
+		if page >= p.GetPages() {
+			return nil
+		}
+	}
+}
+
+// RiskScorer scores and ranks devices by combining a weighted set of risk factors.  The zero
+// value uses DefaultRiskFactors with DefaultRiskWeights.
+type RiskScorer struct {
+	// Factors holds the builders for the factors to score.  Defaults to DefaultRiskFactors.
+	Factors []RiskFactorBuilder
+
+	// Weights holds the weight of each factor by name.  Factors without a weight use their
+	// weight in DefaultRiskWeights, so a factor is excluded by giving it a weight of zero.
+	Weights RiskWeights
+}
+
+// RiskScore holds the risk score of a device and the contribution of each factor to it.
+type RiskScore struct {
+	Device Device
+
+	// Score is the sum of the points from each factor.
+	Score float64
+
+	// Contributions holds the factors which contributed to the score, largest first.
+	Contributions []RiskContribution
+}
+
+// RiskContribution explains the contribution of a single factor to a risk score.
+type RiskContribution struct {
+	Factor string
+	Weight float64
+
+	// Score is the score of the factor from 0 to 1.
+	Score float64
+
+	// Points is the contribution to the risk score, i.e. Score multiplied by Weight.
+	Points float64
+
+	// Reasons explains the score, most significant first.
+	Reasons []string
+}
+
+// RiskRanking scores the risk of each of the devices in the results using the default factors
+// and weights and returns them ranked from highest to lowest.  EoX milestones are assessed
+// against ref.
+func (r *BulkResults) RiskRanking(ref time.Time) []RiskScore {
+	var s RiskScorer
+	return s.Rank(r.RiskInputs(ref))
+}
+
+// Rank scores the risk of each of the devices in the inputs and returns them ranked from
+// highest to lowest, with ties in DeviceId order.
+func (s *RiskScorer) Rank(in *RiskInputs) []RiskScore {
+	builders := s.Factors
+	if builders == nil {
+		builders = DefaultRiskFactors
+	}
+	factors := make([]RiskFactor, len(builders))
+	for i, build := range builders {
+		factors[i] = build(in)
+	}
+	scores := make([]RiskScore, len(in.Devices))
+	for i := range in.Devices {
+		d := &in.Devices[i]
+		rs := RiskScore{Device: *d}
+		for _, f := range factors {
+			weight := s.weight(f.Name())
+			if weight == 0 {
+				continue
+			}
+			score, reasons := f.Score(d)
+			if score <= 0 {
+				continue
+			}
+			c := RiskContribution{Factor: f.Name(), Weight: weight, Score: score, Points: score * weight, Reasons: reasons}
+			rs.Score += c.Points
+			rs.Contributions = append(rs.Contributions, c)
+		}
+		sort.SliceStable(rs.Contributions, func(i, j int) bool {
+			return rs.Contributions[i].Points > rs.Contributions[j].Points
+		})
+		scores[i] = rs
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Device.GetDeviceId() < scores[j].Device.GetDeviceId()
+	})
+	return scores
+}
+
+// weight returns the weight of the named factor.
+func (s *RiskScorer) weight(name string) float64 {
+	if w, ok := s.Weights[name]; ok {
+		return w
+	}
+	return DefaultRiskWeights[name]
+}
+
+// matchConfidence returns the weighting for the match confidence of a PSIRT or field notice,
+// so that potential matches, which require manual verification, count for half.
+func matchConfidence(confidence string) float64 {
+	switch strings.ToLower(strings.TrimSpace(confidence)) {
+	case "vulnerable":
+		return 1
+	case "potentially vulnerable":
+		return 0.5
+	}
+	return 0
+}
+
+// maxRiskReasons is the number of reasons given for a factor before the rest are summarised.
+const maxRiskReasons = 3
+
+// rankedReasons returns the reasons in descending order of score, summarising any beyond
+// maxRiskReasons, along with the highest score.
+func rankedReasons(scores []float64, reasons []string) (float64, []string) {
+	idx := make([]int, len(scores))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return scores[idx[i]] > scores[idx[j]] })
+	var max float64
+	var ranked []string
+	for n, i := range idx {
+		if n == 0 {
+			max = scores[i]
+		}
+		if n == maxRiskReasons {
+			ranked = append(ranked, fmt.Sprintf("and %d more", len(idx)-n))
+			break
+		}
+		ranked = append(ranked, reasons[i])
+	}
+	return max, ranked
+}
+
+// psirtRiskFactor scores devices by the most severe of the PSIRT bulletins matched to them.
+type psirtRiskFactor struct {
+	bulletins  map[int]*PSIRTBulletin
+	advisories map[int][]SecurityAdvisory
+}
+
+// severityRisk holds the score used for each Security Impact Rating where a bulletin has no
+// CVSS base score.
+var severityRisk = map[SeverityImpactRating]float64{
+	SeverityUnknown:       0.3,
+	SeverityInformational: 0.1,
+	SeverityLow:           0.2,
+	SeverityMedium:        0.5,
+	SeverityHigh:          0.8,
+	SeverityCritical:      1,
+}
+
+// NewPSIRTRiskFactor returns a factor scoring devices by the most severe of the PSIRT bulletins
+// matched to them in the SecurityAdvisories.  The severity is the CVSS base score of the
+// bulletin divided by 10, or is derived from its Security Impact Rating where there is no score,
+// and is halved for potential matches.
+func NewPSIRTRiskFactor(in *RiskInputs) RiskFactor {
+	f := &psirtRiskFactor{
+		bulletins:  make(map[int]*PSIRTBulletin),
+		advisories: make(map[int][]SecurityAdvisory),
+	}
+	for i := range in.PSIRTBulletins {
+		f.bulletins[in.PSIRTBulletins[i].GetPsirtColdId()] = &in.PSIRTBulletins[i]
+	}
+	for _, a := range in.SecurityAdvisories {
+		f.advisories[a.GetDeviceId()] = append(f.advisories[a.GetDeviceId()], a)
+	}
+	return f
+}
+
+// Name returns RiskFactorPSIRT.
+func (f *psirtRiskFactor) Name() string {
+	return RiskFactorPSIRT
+}
+
+// Score returns the severity of the most severe PSIRT bulletin for the device.
+func (f *psirtRiskFactor) Score(d *Device) (float64, []string) {
+	var scores []float64
+	var reasons []string
+	for _, a := range f.advisories[d.GetDeviceId()] {
+		confidence := matchConfidence(a.GetMatchConfidence())
+		b, ok := f.bulletins[a.GetPsirtColdId()]
+		if confidence == 0 || !ok {
+			continue
+		}
+		severity := severityRisk[b.Severity()]
+		reason := fmt.Sprintf("%s: %s", b.GetPsirtAdvisoryId(), b.Severity())
+		if cvss, ok := b.CvssBaseScore(); ok {
+			severity = cvss / 10
+			reason += fmt.Sprintf(", CVSS %.1f", cvss)
+		}
+		scores = append(scores, severity*confidence)
+		reasons = append(reasons, fmt.Sprintf("%s (%s)", reason, a.GetMatchConfidence()))
+	}
+	return rankedReasons(scores, reasons)
+}
+
+// fieldNoticeRiskFactor scores devices by the field notices matched to them.
+type fieldNoticeRiskFactor struct {
+	bulletins map[string]*FNBulletin
+	notices   map[int][]FieldNotice
+}
+
+// fnTypeRisk holds the score used for each type of field notice, with other types scoring 0.5.
+var fnTypeRisk = map[string]float64{
+	"hardware": 1,
+	"software": 0.7,
+}
+
+// NewFieldNoticeRiskFactor returns a factor scoring devices by the field notices matched to them
+// in the FieldNotices, where hardware field notices score highest, followed by software field
+// notices.  The score is halved for potential matches.
+func NewFieldNoticeRiskFactor(in *RiskInputs) RiskFactor {
+	f := &fieldNoticeRiskFactor{
+		bulletins: make(map[string]*FNBulletin),
+		notices:   make(map[int][]FieldNotice),
+	}
+	for i := range in.FNBulletins {
+		f.bulletins[strings.TrimSpace(in.FNBulletins[i].GetFieldNoticeId())] = &in.FNBulletins[i]
+	}
+	for _, n := range in.FieldNotices {
+		f.notices[n.GetDeviceId()] = append(f.notices[n.GetDeviceId()], n)
+	}
+	return f
+}
+
+// Name returns RiskFactorFieldNotice.
+func (f *fieldNoticeRiskFactor) Name() string {
+	return RiskFactorFieldNotice
+}
+
+// Score returns the score of the most significant field notice for the device.
+func (f *fieldNoticeRiskFactor) Score(d *Device) (float64, []string) {
+	var scores []float64
+	var reasons []string
+	for _, n := range f.notices[d.GetDeviceId()] {
+		confidence := matchConfidence(n.GetMatchConfidence())
+		b, ok := f.bulletins[strings.TrimSpace(n.GetFieldNoticeId())]
+		if confidence == 0 || !ok {
+			continue
+		}
+		risk, ok := fnTypeRisk[strings.ToLower(strings.TrimSpace(b.GetFnType()))]
+		if !ok {
+			risk = 0.5
+		}
+		scores = append(scores, risk*confidence)
+		reasons = append(reasons, fmt.Sprintf("FN%s: %s (%s)", b.GetFieldNoticeId(), b.GetFnType(), n.GetMatchConfidence()))
+	}
+	return rankedReasons(scores, reasons)
+}
+
+// eoxRiskFactor scores devices by the EoX milestones reached by their hardware and software.
+type eoxRiskFactor struct {
+	hw        []HWEOXBulletin
+	byProduct map[string]int
+	sw        []SWEOXBulletin
+	ref       time.Time
+}
+
+// eoxMilestoneRisk holds the score used for each EoX milestone once reached.
+var eoxMilestoneRisk = map[EoXMilestone]float64{
+	MilestoneEndOfSale:          0.3,
+	MilestoneEndOfSWMaintenance: 0.6,
+	MilestoneLastDateOfSupport:  1,
+}
+
+// eoSecurityVulSupportRisk is the score used once software is past its end of vulnerability and
+// security support.
+const eoSecurityVulSupportRisk = 0.8
+
+// NewEoXRiskFactor returns a factor scoring devices by the latest EoX milestone reached by their
+// hardware or software at the Ref date, so that reaching the last date of support scores highest.
+// Software past its end of vulnerability and security support scores almost as highly.  The
+// bulletins are matched to devices as in the HWEoXExposureReport and SWEoXExposureReport functions.
+func NewEoXRiskFactor(in *RiskInputs) RiskFactor {
+	return &eoxRiskFactor{
+		hw:        in.HWEoxBulletins,
+		byProduct: hwEoXBulletinIndex(in.HWEoxBulletins),
+		sw:        in.SWEoxBulletins,
+		ref:       in.Ref,
+	}
+}
+
+// Name returns RiskFactorEoX.
+func (f *eoxRiskFactor) Name() string {
+	return RiskFactorEoX
+}
+
+// Score returns the score of the latest milestone reached by the device.
+func (f *eoxRiskFactor) Score(d *Device) (float64, []string) {
+	var scores []float64
+	var reasons []string
+	if i, ok := f.byProduct[productKey(d.GetProductId())]; ok {
+		if current, _ := milestonesAt(f.hw[i].Milestones(), f.ref); !current.IsZero() {
+			scores = append(scores, eoxMilestoneRisk[current.Milestone])
+			reasons = append(reasons, fmt.Sprintf("hardware %s: %s on %s", d.GetProductId(), current.Milestone, current.Date.Format(DateFormat)))
+		}
+	}
+	for _, b := range MatchSWEoXBulletins(d, f.sw) {
+		software := fmt.Sprintf("software %s %s", d.GetSwType(), d.GetSwVersion())
+		if current, _ := milestonesAt(b.Milestones(), f.ref); !current.IsZero() {
+			scores = append(scores, eoxMilestoneRisk[current.Milestone])
+			reasons = append(reasons, fmt.Sprintf("%s: %s on %s", software, current.Milestone, current.Date.Format(DateFormat)))
+		}
+		if vul := b.GetEoSecurityVulSupportDate(); !vul.IsZero() && !f.ref.Before(vul.Time) {
+			scores = append(scores, eoSecurityVulSupportRisk)
+			reasons = append(reasons, fmt.Sprintf("%s: end of security support on %s", software, vul.Format(DateFormat)))
+		}
+	}
+	return rankedReasons(scores, reasons)
+}
+
+// crashRiskFactor scores devices by their crash risk.
+type crashRiskFactor struct {
+	risks map[int]*CrashRisk
+}
+
+// NewCrashRiskFactor returns a factor scoring devices by the DeviceRisk in the CrashRisks.  Risks
+// given as a percentage, i.e. greater than 1, are scaled to between 0 and 1.
+func NewCrashRiskFactor(in *RiskInputs) RiskFactor {
+	f := &crashRiskFactor{risks: make(map[int]*CrashRisk)}
+	for i := range in.CrashRisks {
+		f.risks[in.CrashRisks[i].GetDeviceId()] = &in.CrashRisks[i]
+	}
+	return f
+}
+
+// Name returns RiskFactorCrashRisk.
+func (f *crashRiskFactor) Name() string {
+	return RiskFactorCrashRisk
+}
+
+// Score returns the crash risk of the device.
+func (f *crashRiskFactor) Score(d *Device) (float64, []string) {
+	c, ok := f.risks[d.GetDeviceId()]
+	if !ok || c.DeviceRisk == nil {
+		return 0, nil
+	}
+	risk := float64(c.GetDeviceRisk())
+	if risk > 1 {
+		risk /= 100
+	}
+	if risk > 1 {
+		risk = 1
+	}
+	reason := fmt.Sprintf("device risk %.2f", c.GetDeviceRisk())
+	if rank := c.GetGlobalRiskRank(); !IsSentinel(rank) {
+		reason += fmt.Sprintf(", global rank %s", rank)
+	}
+	return risk, []string{reason}
+}
+
+// unreachableRiskFactor scores devices which cannot be reached by the collector.
+type unreachableRiskFactor struct{}
+
+// NewUnreachableRiskFactor returns a factor scoring devices whose DeviceStatus shows that they
+// are not reachable, and so whose data may be out of date, e.g. DEVICE NOT REACHABLE.
+func NewUnreachableRiskFactor(in *RiskInputs) RiskFactor {
+	return unreachableRiskFactor{}
+}
+
+// Name returns RiskFactorUnreachable.
+func (unreachableRiskFactor) Name() string {
+	return RiskFactorUnreachable
+}
+
+// Score returns 1 for an unreachable device.
+func (unreachableRiskFactor) Score(d *Device) (float64, []string) {
+	if unreachable(d) {
+		return 1, []string{"device status " + d.GetDeviceStatus()}
+	}
+	return 0, nil
+}
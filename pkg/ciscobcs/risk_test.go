@@ -0,0 +1,170 @@
+package ciscobcs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRiskScorer(t *testing.T) {
+	ref := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	in := &RiskInputs{
+		Devices: []Device{
+			{DeviceId: Int(1), DeviceStatus: String("ACTIVE"), ProductId: String("WS-C3650-24PD-E")},
+			{DeviceId: Int(2), DeviceStatus: String("DEVICE NOT REACHABLE")},
+			{DeviceId: Int(3), DeviceStatus: String("ACTIVE")},
+		},
+		PSIRTBulletins: []PSIRTBulletin{
+			{PsirtColdId: Int(10), PsirtAdvisoryId: String("cisco-sa-a"), Sir: String("High"), CvssBase: String("8.6")},
+			{PsirtColdId: Int(11), PsirtAdvisoryId: String("cisco-sa-b"), Sir: String("Critical")},
+		},
+		SecurityAdvisories: []SecurityAdvisory{
+			{DeviceId: Int(1), PsirtColdId: Int(10), MatchConfidence: String("Vulnerable")},
+			{DeviceId: Int(1), PsirtColdId: Int(11), MatchConfidence: String("Potentially Vulnerable")},
+			{DeviceId: Int(3), PsirtColdId: Int(11), MatchConfidence: String("Not Vulnerable")},
+		},
+		FNBulletins:  []FNBulletin{{FieldNoticeId: String("63743"), FnType: String("Software")}},
+		FieldNotices: []FieldNotice{{DeviceId: Int(3), FieldNoticeId: String("63743"), MatchConfidence: String("Vulnerable")}},
+		HWEoxBulletins: []HWEOXBulletin{
+			{ProductId: String("WS-C3650-24PD-E"), EoSaleDate: &DateTime{time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)}},
+		},
+		CrashRisks: []CrashRisk{{DeviceId: Int(3), DeviceRisk: Float32(50)}},
+		Ref:        ref,
+	}
+	var s RiskScorer
+	ranked := s.Rank(in)
+	if len(ranked) != 3 {
+		t.Fatalf("got %v scores; want 3", len(ranked))
+	}
+	// device 1: psirt 0.86*40 + eox 0.3*20, device 3: fn 0.7*15 + crash 0.5*15, device 2: 10
+	want := []struct {
+		id      int
+		score   float64
+		factors []string
+	}{
+		{1, 40.4, []string{RiskFactorPSIRT, RiskFactorEoX}},
+		{3, 18, []string{RiskFactorFieldNotice, RiskFactorCrashRisk}},
+		{2, 10, []string{RiskFactorUnreachable}},
+	}
+	for i, w := range want {
+		got := ranked[i]
+		if got.Device.GetDeviceId() != w.id || got.Score < w.score-0.001 || got.Score > w.score+0.001 {
+			t.Errorf("%d: got device %v with %v; want device %v with %v", i, got.Device.GetDeviceId(), got.Score, w.id, w.score)
+			continue
+		}
+		if len(got.Contributions) != len(w.factors) {
+			t.Errorf("device %v: got contributions %+v; want %v", w.id, got.Contributions, w.factors)
+			continue
+		}
+		for j, f := range w.factors {
+			if got.Contributions[j].Factor != f {
+				t.Errorf("device %v: got factor %v; want %v", w.id, got.Contributions[j].Factor, f)
+			}
+		}
+	}
+	if got := ranked[0].Contributions[0].Reasons; len(got) != 2 || got[0] != "cisco-sa-a: High, CVSS 8.6 (Vulnerable)" {
+		t.Errorf("got reasons %q", got)
+	}
+
+	t.Run("weights", func(t *testing.T) {
+		s := RiskScorer{
+			Factors: []RiskFactorBuilder{NewUnreachableRiskFactor, NewPSIRTRiskFactor},
+			Weights: RiskWeights{RiskFactorUnreachable: 100, RiskFactorPSIRT: 0},
+		}
+		ranked := s.Rank(in)
+		if ranked[0].Device.GetDeviceId() != 2 || ranked[0].Score != 100 || ranked[1].Score != 0 {
+			t.Errorf("got %v with %v then %v; want device 2 with 100 then 0", ranked[0].Device.GetDeviceId(), ranked[0].Score, ranked[1].Score)
+		}
+	})
+
+	t.Run("enrich", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path + "?" + r.URL.RawQuery {
+			case "/customer/12345/productAlerts/fieldNotices?page=1":
+				w.Write([]byte(`{"page":1,"pages":2,"items":[{"deviceId":1,"fieldNoticeId":"63743","matchConfidence":"Vulnerable"}]}`))
+			case "/customer/12345/productAlerts/fieldNotices?page=2":
+				w.Write([]byte(`{"page":2,"pages":2,"items":[{"deviceId":2,"fieldNoticeId":"63743","matchConfidence":"Vulnerable"}]}`))
+			case "/customer/12345/crashPrevention/crashRisk?page=1":
+				w.Write([]byte(`{"page":1,"pages":1,"items":[{"deviceId":1,"deviceRisk":0.25}]}`))
+			default:
+				t.Errorf("unexpected request %v", r.URL)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		in := &RiskInputs{SecurityAdvisories: []SecurityAdvisory{{DeviceId: Int(1)}}}
+		if err := in.Enrich(context.Background(), c, "12345"); err != nil {
+			t.Fatal(err)
+		}
+		if len(in.FieldNotices) != 2 || len(in.CrashRisks) != 1 {
+			t.Errorf("got %v field notices and %v crash risks; want 2 and 1", len(in.FieldNotices), len(in.CrashRisks))
+		}
+		if err := in.Enrich(context.Background(), c, "12345"); err != nil {
+			t.Fatal(err)
+		}
+		if len(in.FieldNotices) != 2 || len(in.CrashRisks) != 1 || len(in.SecurityAdvisories) != 1 {
+			t.Errorf("got %v field notices, %v crash risks and %v advisories enriching again; want 2, 1 and 1", len(in.FieldNotices), len(in.CrashRisks), len(in.SecurityAdvisories))
+		}
+	})
+	t.Run("enrich after error", func(t *testing.T) {
+		fail := true
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path + "?" + r.URL.RawQuery {
+			case "/customer/12345/productAlerts/fieldNotices?page=1":
+				w.Write([]byte(`{"page":1,"pages":1,"items":[{"deviceId":1,"fieldNoticeId":"63743","matchConfidence":"Vulnerable"}]}`))
+			case "/customer/12345/crashPrevention/crashRisk?page=1":
+				w.Write([]byte(`{"page":1,"pages":2,"items":[{"deviceId":1,"deviceRisk":0.25}]}`))
+			case "/customer/12345/crashPrevention/crashRisk?page=2":
+				if fail {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Write([]byte(`{"page":2,"pages":2,"items":[{"deviceId":2,"deviceRisk":0.5}]}`))
+			default:
+				t.Errorf("unexpected request %v", r.URL)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+		in := &RiskInputs{SecurityAdvisories: []SecurityAdvisory{{DeviceId: Int(1)}}}
+		if err := in.Enrich(context.Background(), c, "12345"); err == nil {
+			t.Fatal("expected error enriching")
+		}
+		if len(in.FieldNotices) != 1 || len(in.CrashRisks) != 0 {
+			t.Errorf("got %v field notices and %v crash risks; want 1 and none", len(in.FieldNotices), len(in.CrashRisks))
+		}
+		fail = false
+		if err := in.Enrich(context.Background(), c, "12345"); err != nil {
+			t.Fatal(err)
+		}
+		if len(in.FieldNotices) != 1 || len(in.CrashRisks) != 2 {
+			t.Errorf("got %v field notices and %v crash risks; want 1 and 2", len(in.FieldNotices), len(in.CrashRisks))
+		}
+	})
+
+	t.Run("demo", func(t *testing.T) {
+		ranked := demoResults(t).RiskRanking(ref)
+		if len(ranked) != 300 {
+			t.Fatalf("got %v scores; want 300", len(ranked))
+		}
+		for i := 1; i < len(ranked); i++ {
+			if ranked[i].Score > ranked[i-1].Score {
+				t.Fatalf("scores not ranked at %d: %v > %v", i, ranked[i].Score, ranked[i-1].Score)
+			}
+		}
+		top := ranked[0]
+		if top.Score <= 0 || len(top.Contributions) == 0 || top.Contributions[0].Factor != RiskFactorPSIRT {
+			t.Errorf("got top device %v with %+v; want a PSIRT led score", top.Device.GetDeviceId(), top.Contributions)
+		}
+		notices := 0
+		for _, s := range ranked {
+			for _, c := range s.Contributions {
+				if c.Factor == RiskFactorFieldNotice && c.Score > 0 {
+					notices++
+				}
+			}
+		}
+		if notices == 0 {
+			t.Errorf("got no field notice contributions; want those from the bulk data")
+		}
+	})
+}
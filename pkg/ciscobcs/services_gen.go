@@ -0,0 +1,588 @@
+// Code generated by convert-swagger-to-openapi; DO NOT EDIT.
+
+// Instead, please run "go generate ./..." from the root of the repository.
+
+package ciscobcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CountListOptions specifies the optional parameters to the CountService.List method.
+type CountListOptions struct {
+	ListOptions
+
+	// Total days to look backward
+	DaysBackward int `url:"days_backward,omitempty"`
+}
+
+// FeedbackRequest defines the request body for the FeedbackService.Create method.
+type FeedbackRequest struct {
+	App      *string `json:"app,omitempty"`
+	Email    *string `json:"email,omitempty"`
+	Feedback *string `json:"feedback,omitempty"`
+	Rating   *int    `json:"rating,omitempty"`
+	Screen   *string `json:"screen,omitempty"`
+}
+
+// RiskMitigationCountCrashesOptions specifies the optional parameters to the RiskMitigationService.CountCrashes method.
+type RiskMitigationCountCrashesOptions struct {
+	QueryOptions
+
+	// Days too look backward for counting
+	Days int `url:"days,omitempty"`
+}
+
+// List calls GET /customer/{customerid}/collectors.
+//
+// List the collectors
+func (s *CollectorsService) List(ctx context.Context, customerID string) (*PageOfCollectors, error) {
+	u := fmt.Sprintf("%s/customer/%s/collectors", s.client.BaseURL, url.PathEscape(customerID))
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCollectors)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListDetails calls GET /customer/{customerid}/cbp/details.
+//
+// CBP Rules details found per deviceid
+func (s *ConfigurationBestPracticeService) ListDetails(ctx context.Context, customerID string, opts *ListOptions) (*PageOfCBPdetails, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/cbp/details", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCBPdetails)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListRuleReferences calls GET /customer/{customerid}/cbp/rulesReferences.
+//
+// CBP Rules references found per deviceid
+func (s *ConfigurationBestPracticeService) ListRuleReferences(ctx context.Context, customerID string, opts *ListOptions) (*PageOfCBPrulesreferences, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/cbp/rulesReferences", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCBPrulesreferences)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListRules calls GET /customer/{customerid}/cbp/rules.
+//
+// CBP Rules
+func (s *ConfigurationBestPracticeService) ListRules(ctx context.Context, customerID string, opts *ListOptions) (*PageOfCBPrules, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/cbp/rules", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCBPrules)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListSummary calls GET /customer/{customerid}/cbp/summary.
+//
+// CBP aggregation
+func (s *ConfigurationBestPracticeService) ListSummary(ctx context.Context, customerID string, opts *ListOptions) (*PageOfCBPsummary, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/cbp/summary", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCBPsummary)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListSerialNumbers calls GET /customer/{customerid}/contract/serials.
+//
+// Contract informations per serial number
+func (s *ContractService) ListSerialNumbers(ctx context.Context, customerID string, opts *ListOptions) (*PageOfSerialNumberDetails, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/contract/serials", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfSerialNumberDetails)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// List calls GET /customer/{customerid}/count/.
+//
+// Returns the amount of data stored in DB, time series per day
+func (s *CountService) List(ctx context.Context, customerID string, opts *CountListOptions) (*PageOfCountDataPoint, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/count/", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCountDataPoint)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CountCrashRisks calls GET /customer/{customerid}/crashPrevention/crashRiskCount.
+//
+// Counting all crash risks per level
+func (s *CrashPreventionService) CountCrashRisks(ctx context.Context, customerID string, opts *QueryOptions) (json.RawMessage, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/crashPrevention/crashRiskCount", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var v json.RawMessage
+	if err := s.client.makeRequest(ctx, req, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListCrashRisks calls GET /customer/{customerid}/crashPrevention/crashRisk.
+//
+// Listing all crash risks
+func (s *CrashPreventionService) ListCrashRisks(ctx context.Context, customerID string, opts *ListOptions) (*PageOfCrashes, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/crashPrevention/crashRisk", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCrashes)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Create calls POST /customer/{customerid}/feedback.
+//
+// Manage feedback
+func (s *FeedbackService) Create(ctx context.Context, customerID string, body *FeedbackRequest) (*Feedback, error) {
+	u := fmt.Sprintf("%s/customer/%s/feedback", s.client.BaseURL, url.PathEscape(customerID))
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", u, bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	v := new(Feedback)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Delete calls DELETE /customer/{customerid}/feedback/id/{feedback_id}.
+//
+// Manage feedback
+func (s *FeedbackService) Delete(ctx context.Context, customerID string, feedbackID string) error {
+	u := fmt.Sprintf("%s/customer/%s/feedback/id/%s", s.client.BaseURL, url.PathEscape(customerID), url.PathEscape(feedbackID))
+	req, err := http.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return s.client.makeRequest(ctx, req, nil)
+}
+
+// List calls GET /customer/{customerid}/feedback.
+//
+// Manage feedback
+func (s *FeedbackService) List(ctx context.Context, customerID string, opts *ListOptions) (*PageOfFeedback, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/feedback", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfFeedback)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CountAssets calls GET /customer/{customerid}/inventory/assets/count.
+//
+// Total assets count
+func (s *InventoryService) CountAssets(ctx context.Context, customerID string) (int, error) {
+	u := fmt.Sprintf("%s/customer/%s/inventory/assets/count", s.client.BaseURL, url.PathEscape(customerID))
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	var v int
+	if err := s.client.makeRequest(ctx, req, &v); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// CountDevices calls GET /customer/{customerid}/inventory/devices/count.
+//
+// Total devices count
+func (s *InventoryService) CountDevices(ctx context.Context, customerID string) (int, error) {
+	u := fmt.Sprintf("%s/customer/%s/inventory/devices/count", s.client.BaseURL, url.PathEscape(customerID))
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	var v int
+	if err := s.client.makeRequest(ctx, req, &v); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// ListAssets calls GET /customer/{customerid}/inventory/assets.
+//
+// Listing assets details (actual hardware, primary key physicalelementid, groupped by deviceid)
+func (s *InventoryService) ListAssets(ctx context.Context, customerID string, opts *ListOptions) (*PageOfAssets, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/inventory/assets", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfAssets)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListDevices calls GET /customer/{customerid}/inventory/devices.
+//
+// Device informations (logical device with primary key deviceid)
+func (s *InventoryService) ListDevices(ctx context.Context, customerID string, opts *ListOptions) (*PageOfDevices, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/inventory/devices", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfDevices)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListFNBulletins calls GET /customer/{customerid}/productAlerts/fnBulletins.
+//
+// Listing Field Notices Bulletins
+func (s *ProductAlertService) ListFNBulletins(ctx context.Context, customerID string, opts *ListOptions) (*PageOfFNBulletins, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/fnBulletins", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfFNBulletins)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListFieldNotices calls GET /customer/{customerid}/productAlerts/fieldNotices.
+//
+// Listing known field notices for devices (linked to devices by deviceid)
+func (s *ProductAlertService) ListFieldNotices(ctx context.Context, customerID string, opts *ListOptions) (*PageOfFieldNotices, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/fieldNotices", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfFieldNotices)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListHWEOX calls GET /customer/{customerid}/productAlerts/hwEox.
+//
+// Listing known hardware EOX for assets (linked to assets by physicalelementid)
+func (s *ProductAlertService) ListHWEOX(ctx context.Context, customerID string, opts *ListOptions) (*PageOfHWEOX, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/hwEox", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfHWEOX)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListHWEOXBulletins calls GET /customer/{customerid}/productAlerts/hwEoxBulletins.
+//
+// Listing Hardware EOX Bulletins
+func (s *ProductAlertService) ListHWEOXBulletins(ctx context.Context, customerID string, opts *ListOptions) (*PageOfHWEOXBulletins, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/hwEoxBulletins", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfHWEOXBulletins)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListPSIRTBulletins calls GET /customer/{customerid}/productAlerts/psirtBulletins.
+//
+// Listing PSIRT Bulletins
+func (s *ProductAlertService) ListPSIRTBulletins(ctx context.Context, customerID string, opts *ListOptions) (*PageOfPSIRTBulletins, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/psirtBulletins", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfPSIRTBulletins)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListSWEOX calls GET /customer/{customerid}/productAlerts/swEox.
+//
+// Listing known software EOX for devices (linked to devices by deviceid)
+func (s *ProductAlertService) ListSWEOX(ctx context.Context, customerID string, opts *ListOptions) (*PageOfSoftwareEOX, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/swEox", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfSoftwareEOX)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListSWEOXBulletins calls GET /customer/{customerid}/productAlerts/swEoxBulletins.
+//
+// Listing Software EOX Bulletins
+func (s *ProductAlertService) ListSWEOXBulletins(ctx context.Context, customerID string, opts *ListOptions) (*PageOfSWEOXBulletins, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/swEoxBulletins", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfSWEOXBulletins)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListSecurityAdvisories calls GET /customer/{customerid}/productAlerts/psirt.
+//
+// Listing known security advisories (PSIRT) for devices (linked to devices by deviceid).
+//
+//	Confidence is: "Not Vulnerable, Vulnerable, Potentially Vulnerable".
+func (s *ProductAlertService) ListSecurityAdvisories(ctx context.Context, customerID string, opts *ListOptions) (*PageOfSecurityAdvisories, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/psirt", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfSecurityAdvisories)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListSoftwareAlerts calls GET /customer/{customerid}/productAlerts/swAlerts.
+//
+// Listing known software alerts for devices (linked to devices by deviceid)
+func (s *ProductAlertService) ListSoftwareAlerts(ctx context.Context, customerID string, opts *ListOptions) (*PageOfSoftwareAlerts, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/productAlerts/swAlerts", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfSoftwareAlerts)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CountCrashes calls GET /customer/{customerid}/riskMitigation/crashCount.
+//
+// Count crashes since days
+func (s *RiskMitigationService) CountCrashes(ctx context.Context, customerID string, opts *RiskMitigationCountCrashesOptions) (*PageOfCrashes, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/riskMitigation/crashCount", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCrashes)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListCrashes calls GET /customer/{customerid}/riskMitigation/crashes.
+//
+// Listing all crashes
+func (s *RiskMitigationService) ListCrashes(ctx context.Context, customerID string, opts *ListOptions) (*PageOfCrashes, error) {
+	u, err := addOptions(fmt.Sprintf("%s/customer/%s/riskMitigation/crashes", s.client.BaseURL, url.PathEscape(customerID)), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(PageOfCrashes)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetCompliance calls GET /customer/{customerid}/softwareTrack/compliance.
+//
+// Lists of compliances for devices
+func (s *SoftwareTrackService) GetCompliance(ctx context.Context, customerID string) (*TrackCompliance, error) {
+	u := fmt.Sprintf("%s/customer/%s/softwareTrack/compliance", s.client.BaseURL, url.PathEscape(customerID))
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(TrackCompliance)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetRecommendations calls GET /customer/{customerid}/softwareTrack/recommendations.
+//
+// Lists of recomendations for devices
+func (s *SoftwareTrackService) GetRecommendations(ctx context.Context, customerID string) (*TrackSmupieRecommendation, error) {
+	u := fmt.Sprintf("%s/customer/%s/softwareTrack/recommendations", s.client.BaseURL, url.PathEscape(customerID))
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(TrackSmupieRecommendation)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetSummary calls GET /customer/{customerid}/softwareTrack/summary.
+//
+// Lists historic data which contains informations about software upgrades
+func (s *SoftwareTrackService) GetSummary(ctx context.Context, customerID string) (*TrackSummary, error) {
+	u := fmt.Sprintf("%s/customer/%s/softwareTrack/summary", s.client.BaseURL, url.PathEscape(customerID))
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := new(TrackSummary)
+	if err := s.client.makeRequest(ctx, req, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,127 @@
+package ciscobcs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// setup returns a client for a test server using the given handler.
+func setup(t *testing.T, h http.HandlerFunc) *Client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	c, err := NewClient("apikey", ts.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.BaseURL = ts.URL
+	return c
+}
+
+func TestServices(t *testing.T) {
+	t.Run("list with options", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			if got, want := r.URL.Path, "/customer/12345/inventory/devices"; got != want {
+				t.Errorf("got path %v; want %v", got, want)
+			}
+			if got, want := r.URL.RawQuery, "filter=%7B%22productType%22%3A%22Routers%22%7D&page=2"; got != want {
+				t.Errorf("got query %v; want %v", got, want)
+			}
+			if got := r.Header.Get("x-api-key"); got != "apikey" {
+				t.Errorf("got api key %v; want apikey", got)
+			}
+			w.Write([]byte(`{"page":2,"pages":2,"perPage":500,"total":501,"items":[{"deviceId":1,"deviceName":"router1"}]}`))
+		})
+		page, err := c.InventoryService.ListDevices(context.Background(), "12345", &ListOptions{Filter: `{"productType":"Routers"}`, Page: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.GetTotal() != 501 || len(page.Items) != 1 || page.Items[0].GetDeviceName() != "router1" {
+			t.Errorf("unexpected page %+v", page)
+		}
+	})
+	t.Run("generated options", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			if got, want := r.URL.RawQuery, "days_backward=30&perPage=10"; got != want {
+				t.Errorf("got query %v; want %v", got, want)
+			}
+			w.Write([]byte(`{"items":[{"count":10,"date":"2021-08-01","tableName":"assets"}]}`))
+		})
+		page, err := c.CountService.List(context.Background(), "12345", &CountListOptions{ListOptions: ListOptions{PerPage: 10}, DaysBackward: 30})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Items) != 1 || page.Items[0].GetDate().String() != "2021-08-01" {
+			t.Errorf("unexpected page %+v", page)
+		}
+	})
+	t.Run("empty mask omitted", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			if got, want := r.URL.RawQuery, "page=2"; got != want {
+				t.Errorf("got query %v; want %v", got, want)
+			}
+			w.Write([]byte(`{"page":2,"items":[]}`))
+		})
+		if m := ItemsMask(); m != nil {
+			t.Errorf("got %v; want nil mask", m)
+		}
+		for _, m := range []Mask{{}, ItemsMask()} {
+			if _, err := c.InventoryService.ListDevices(context.Background(), "12345", &ListOptions{Mask: m, Page: 2}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	t.Run("count", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`300`))
+		})
+		n, err := c.InventoryService.CountDevices(context.Background(), "12345")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 300 {
+			t.Errorf("got %v; want 300", n)
+		}
+	})
+	t.Run("create and delete", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case "POST":
+				var body FeedbackRequest
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Error(err)
+				}
+				if body.GetRating() != 5 {
+					t.Errorf("got rating %v; want 5", body.GetRating())
+				}
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"id":1,"rating":5}`))
+			case "DELETE":
+				if got, want := r.URL.Path, "/customer/12345/feedback/id/1"; got != want {
+					t.Errorf("got path %v; want %v", got, want)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+		})
+		fb, err := c.FeedbackService.Create(context.Background(), "12345", &FeedbackRequest{Rating: Int(5)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fb.GetRating() != 5 {
+			t.Errorf("got rating %v; want 5", fb.GetRating())
+		}
+		if err := c.FeedbackService.Delete(context.Background(), "12345", "1"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("bad request", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		if _, err := c.ProductAlertService.ListPSIRTBulletins(context.Background(), "12345", nil); err != ErrBadRequest {
+			t.Errorf("got %v; want %v", err, ErrBadRequest)
+		}
+	})
+}
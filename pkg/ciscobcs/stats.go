@@ -0,0 +1,182 @@
+package ciscobcs
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Grouping specifies the fields to group the records of a bulk type by, e.g. the devices by
+// swType and swVersion.  Fields are the json names of the model fields, ignoring case.
+type Grouping struct {
+	Type   string   `json:"type"`
+	Fields []string `json:"fields"`
+}
+
+// String returns the grouping as the type and fields, e.g. device by swType, swVersion.
+func (g Grouping) String() string {
+	return fmt.Sprintf("%s by %s", g.Type, strings.Join(g.Fields, ", "))
+}
+
+// DefaultGroupings are the groupings typically looked at after a download: the devices by
+// product family, software type, software version, status and collector, the PSIRT bulletins
+// by security impact rating and the field notices by type.
+var DefaultGroupings = []Grouping{
+	{Type: "device", Fields: []string{"productFamily"}},
+	{Type: "device", Fields: []string{"swType"}},
+	{Type: "device", Fields: []string{"swVersion"}},
+	{Type: "device", Fields: []string{"deviceStatus"}},
+	{Type: "device", Fields: []string{"collector"}},
+	{Type: "psirt_bulletin", Fields: []string{"sir"}},
+	{Type: "fn_bulletin", Fields: []string{"fnType"}},
+}
+
+// GroupCount is the number of records with a combination of values for the fields grouped by.
+// Values are as they appear in the JSON, with an empty string where the field is missing.
+type GroupCount struct {
+	Values  []string `json:"values"`
+	Count   int      `json:"count"`
+	Percent float64  `json:"percent"`
+}
+
+// GroupStats holds the number of records for each combination of values of the fields grouped by,
+// ordered by the number of records, most first.
+type GroupStats struct {
+	Grouping
+	Total  int          `json:"total"`
+	Groups []GroupCount `json:"groups"`
+	// Other is the number of records in the groups omitted by Top.
+	Other int `json:"other,omitempty"`
+}
+
+// Top returns a copy of the stats with only the n largest groups, with the records in the rest
+// counted in Other.  All of the groups are kept where n is zero or less.
+func (s *GroupStats) Top(n int) *GroupStats {
+	top := *s
+	if n <= 0 || n >= len(s.Groups) {
+		return &top
+	}
+	top.Groups = s.Groups[:n]
+	for _, g := range s.Groups[n:] {
+		top.Other += g.Count
+	}
+	return &top
+}
+
+// GroupBy groups records, which must be a slice of one of the model types, by the given fields.
+// An error wrapping ErrUnknownGroupField is returned where the type does not have a field.
+func GroupBy(records interface{}, fields ...string) (*GroupStats, error) {
+	return groupBy(records, fields, nil)
+}
+
+// groupBy groups the records by the fields, which can include tags where the records are devices
+// and tags is not nil.
+func groupBy(records interface{}, fields []string, tags DeviceTags) (*GroupStats, error) {
+	v := reflect.ValueOf(records)
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %T", ErrUnrecognisedBulkType, records)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: no fields", ErrUnknownGroupField)
+	}
+	t := v.Type().Elem()
+	names := jsonFields(t)
+	columns := make([]func(reflect.Value) string, len(fields))
+	for i, f := range fields {
+		if tags != nil && t == reflect.TypeOf(Device{}) && strings.HasPrefix(f, TagFieldPrefix) {
+			name := strings.TrimPrefix(f, TagFieldPrefix)
+			columns[i] = func(v reflect.Value) string {
+				return tags.Tag(v.Addr().Interface().(*Device), name)
+			}
+			continue
+		}
+		index, ok := names[strings.ToLower(f)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownGroupField, f)
+		}
+		columns[i] = func(v reflect.Value) string {
+			s, _ := jsonFieldString(v.Field(index))
+			return s
+		}
+	}
+
+	s := &GroupStats{Grouping: Grouping{Type: t.Name(), Fields: fields}, Total: v.Len()}
+	groups := make(map[string]int)
+	for i := 0; i < v.Len(); i++ {
+		values := make([]string, len(columns))
+		for j, column := range columns {
+			values[j] = column(v.Index(i))
+		}
+		// the unit separator will not appear in the values
+		key := strings.Join(values, "\x1f")
+		g, ok := groups[key]
+		if !ok {
+			g = len(s.Groups)
+			groups[key] = g
+			s.Groups = append(s.Groups, GroupCount{Values: values})
+		}
+		s.Groups[g].Count++
+	}
+	for i := range s.Groups {
+		s.Groups[i].Percent = 100 * float64(s.Groups[i].Count) / float64(s.Total)
+	}
+	sort.SliceStable(s.Groups, func(i, j int) bool {
+		if s.Groups[i].Count != s.Groups[j].Count {
+			return s.Groups[i].Count > s.Groups[j].Count
+		}
+		return strings.Join(s.Groups[i].Values, "\x1f") < strings.Join(s.Groups[j].Values, "\x1f")
+	})
+	return s, nil
+}
+
+// Stats groups the records of the given bulk type, e.g. device or psirt_bulletin, by the given
+// fields.  Devices can also be grouped by their Tags, e.g. tag:site.  A PSIRT bulletin is listed
+// once for each of the platforms it affects, so PSIRT bulletins are counted once for each
+// PsirtAdvisoryId, using the first listing.
+func (r *BulkResults) Stats(bulkType string, fields ...string) (*GroupStats, error) {
+	records, err := r.records(bulkType)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{} = records.Interface()
+	if bulletins, ok := v.([]PSIRTBulletin); ok {
+		v = uniquePSIRTBulletins(bulletins)
+	}
+	s, err := groupBy(v, fields, r.Tags)
+	if err != nil {
+		return nil, err
+	}
+	s.Type = bulkType
+	return s, nil
+}
+
+// uniquePSIRTBulletins returns the first listing of each PSIRT bulletin, along with any listings
+// without a PsirtAdvisoryId.
+func uniquePSIRTBulletins(bulletins []PSIRTBulletin) []PSIRTBulletin {
+	seen := make(map[string]bool)
+	unique := make([]PSIRTBulletin, 0, len(bulletins))
+	for _, b := range bulletins {
+		if id := bulletinKey(b.GetPsirtAdvisoryId()); id != "" {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+		}
+		unique = append(unique, b)
+	}
+	return unique
+}
+
+// StatsFor returns the stats for each of the groupings, e.g. DefaultGroupings, in order.
+func (r *BulkResults) StatsFor(groupings []Grouping) ([]*GroupStats, error) {
+	stats := make([]*GroupStats, 0, len(groupings))
+	for _, g := range groupings {
+		s, err := r.Stats(g.Type, g.Fields...)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
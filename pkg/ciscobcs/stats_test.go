@@ -0,0 +1,83 @@
+package ciscobcs
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	devices := []Device{
+		{SwType: String("IOS-XE"), SwVersion: String("17.3.4")},
+		{SwType: String("IOS-XE"), SwVersion: String("16.9.5")},
+		{SwType: String("IOS-XE"), SwVersion: String("17.3.4")},
+		{SwType: String("NX-OS"), SwVersion: String("9.3(8)")},
+		{},
+	}
+	s, err := GroupBy(devices, "swtype", "swVersion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []GroupCount{
+		{Values: []string{"IOS-XE", "17.3.4"}, Count: 2, Percent: 40},
+		{Values: []string{"", ""}, Count: 1, Percent: 20},
+		{Values: []string{"IOS-XE", "16.9.5"}, Count: 1, Percent: 20},
+		{Values: []string{"NX-OS", "9.3(8)"}, Count: 1, Percent: 20},
+	}
+	if s.Total != 5 || !reflect.DeepEqual(s.Groups, want) {
+		t.Errorf("got %+v; want %+v", s.Groups, want)
+	}
+	top := s.Top(2)
+	if len(top.Groups) != 2 || top.Other != 2 || len(s.Groups) != 4 || s.Other != 0 {
+		t.Errorf("got top %v other %v; want 2 groups and 2 other", len(top.Groups), top.Other)
+	}
+	if top := s.Top(0); len(top.Groups) != 4 || top.Other != 0 {
+		t.Errorf("got top %v other %v; want every group", len(top.Groups), top.Other)
+	}
+	if _, err := GroupBy(devices, "sir"); !errors.Is(err, ErrUnknownGroupField) {
+		t.Errorf("got %v; want %v", err, ErrUnknownGroupField)
+	}
+	if _, err := GroupBy(devices[0], "swType"); !errors.Is(err, ErrUnrecognisedBulkType) {
+		t.Errorf("got %v; want %v", err, ErrUnrecognisedBulkType)
+	}
+
+	t.Run("demo", func(t *testing.T) {
+		results := demoResults(t)
+		stats, err := results.StatsFor(DefaultGroupings)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantTotals := map[string]int{"device": 300, "psirt_bulletin": 332, "fn_bulletin": 103}
+		for i, s := range stats {
+			if s.Grouping.String() != DefaultGroupings[i].String() {
+				t.Errorf("got %v; want %v", s.Grouping, DefaultGroupings[i])
+			}
+			sum := 0
+			for _, g := range s.Groups {
+				sum += g.Count
+			}
+			if s.Total != wantTotals[s.Type] || sum != s.Total {
+				t.Errorf("%v: got total %v, sum %v; want %v", s.Grouping, s.Total, sum, wantTotals[s.Type])
+			}
+		}
+		s, err := results.Stats("device", "deviceStatus")
+		if err != nil {
+			t.Fatal(err)
+		}
+		unreachable := 0
+		for _, d := range results.Devices {
+			if d.GetDeviceStatus() == "DEVICE NOT REACHABLE" {
+				unreachable++
+			}
+		}
+		found := false
+		for _, g := range s.Groups {
+			if g.Values[0] == "DEVICE NOT REACHABLE" {
+				found = g.Count == unreachable
+			}
+		}
+		if !found || unreachable == 0 {
+			t.Errorf("got %+v; want %v unreachable", s.Groups, unreachable)
+		}
+	})
+}
@@ -0,0 +1,236 @@
+package ciscobcs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// TagFieldPrefix identifies a derived tag where a field name is expected, e.g. tag:site when
+// grouping devices with DeviceTags.GroupBy.
+const TagFieldPrefix = "tag:"
+
+// TagRules assigns tags, such as the site, team or business unit, to devices using the details
+// from the collector seedfile, i.e. the SysLocation, DeviceName and UserField1 to UserField4.
+// Rules are evaluated in order, and each tag takes its value from the first matching rule which
+// sets it, or otherwise from Defaults.  Rules are typically loaded from YAML or JSON, e.g.
+//
+//	rules:
+//	  - sysLocation: '^(?P<site>[A-Z]{3})-'
+//	    tags: {site: '${site}'}
+//	  - deviceNamePrefix: lon-
+//	    tags: {site: LON}
+//	  - userFields: {userField1: '^netops$'}
+//	    tags: {team: NetOps, businessUnit: Infrastructure}
+//	defaults:
+//	  site: Unknown
+type TagRules struct {
+	Rules    []TagRule         `json:"rules"`
+	Defaults map[string]string `json:"defaults,omitempty"`
+}
+
+// TagRule sets tags on the devices which match all of its conditions.  A rule without any
+// conditions matches every device.  Values in Tags can refer to the submatches of the SysLocation
+// expression, e.g. $1 or ${site}.
+type TagRule struct {
+	// SysLocation is a regular expression matched against the SysLocation of the device.
+	SysLocation string `json:"sysLocation,omitempty"`
+
+	// DeviceNamePrefix matches devices whose DeviceName starts with the prefix, ignoring case.
+	DeviceNamePrefix string `json:"deviceNamePrefix,omitempty"`
+
+	// UserFields holds regular expressions matched against the user fields of the device, keyed
+	// by the name of the field, i.e. userField1 to userField4.
+	UserFields map[string]string `json:"userFields,omitempty"`
+
+	Tags map[string]string `json:"tags"`
+
+	sysLocation *regexp.Regexp
+	userFields  []userFieldMatcher
+}
+
+// userFieldMatcher matches the value of a single user field.
+type userFieldMatcher struct {
+	field   func(*Device) string
+	pattern *regexp.Regexp
+}
+
+// userFields maps the names of the user fields to their accessors.
+var userFields = map[string]func(*Device) string{
+	"userfield1": (*Device).GetUserField1,
+	"userfield2": (*Device).GetUserField2,
+	"userfield3": (*Device).GetUserField3,
+	"userfield4": (*Device).GetUserField4,
+}
+
+// ParseTagRules parses rules in YAML or JSON.  An error wrapping ErrInvalidTagRules is returned
+// where the rules are malformed, have an unknown field or an invalid regular expression.
+func ParseTagRules(data []byte) (*TagRules, error) {
+	b, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTagRules, err)
+	}
+	var r TagRules
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&r); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTagRules, err)
+	}
+	for i := range r.Rules {
+		if err := r.Rules[i].compile(); err != nil {
+			return nil, fmt.Errorf("%w: rule %d: %v", ErrInvalidTagRules, i+1, err)
+		}
+	}
+	return &r, nil
+}
+
+// LoadTagRules reads and parses the rules in the given YAML or JSON file.
+func LoadTagRules(filename string) (*TagRules, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTagRules(data)
+}
+
+// compile compiles the regular expressions of the rule.
+func (r *TagRule) compile() error {
+	if len(r.Tags) == 0 {
+		return fmt.Errorf("no tags")
+	}
+	if r.SysLocation != "" {
+		re, err := regexp.Compile(r.SysLocation)
+		if err != nil {
+			return err
+		}
+		r.sysLocation = re
+	}
+	r.userFields = nil
+	for name, expr := range r.UserFields {
+		field, ok := userFields[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("unknown user field %s", name)
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return err
+		}
+		r.userFields = append(r.userFields, userFieldMatcher{field: field, pattern: re})
+	}
+	return nil
+}
+
+// match reports whether the device matches the rule, returning the submatch indexes of the
+// SysLocation expression, if any.
+func (r *TagRule) match(d *Device) ([]int, bool) {
+	var submatches []int
+	if r.sysLocation != nil {
+		if submatches = r.sysLocation.FindStringSubmatchIndex(d.GetSysLocation()); submatches == nil {
+			return nil, false
+		}
+	}
+	if r.DeviceNamePrefix != "" && !strings.HasPrefix(strings.ToLower(d.GetDeviceName()), strings.ToLower(r.DeviceNamePrefix)) {
+		return nil, false
+	}
+	for _, m := range r.userFields {
+		if !m.pattern.MatchString(m.field(d)) {
+			return nil, false
+		}
+	}
+	return submatches, true
+}
+
+// Tags returns the tags for the device.  Tags whose value is empty are omitted.
+func (r *TagRules) Tags(d *Device) map[string]string {
+	tags := make(map[string]string)
+	for i := range r.Rules {
+		rule := &r.Rules[i]
+		submatches, ok := rule.match(d)
+		if !ok {
+			continue
+		}
+		for name, value := range rule.Tags {
+			if _, ok := tags[name]; ok {
+				continue
+			}
+			if submatches != nil {
+				value = string(rule.sysLocation.ExpandString(nil, value, d.GetSysLocation(), submatches))
+			}
+			if value != "" {
+				tags[name] = value
+			}
+		}
+	}
+	for name, value := range r.Defaults {
+		if _, ok := tags[name]; !ok && value != "" {
+			tags[name] = value
+		}
+	}
+	return tags
+}
+
+// Names returns the names of the tags set by the rules or defaults, sorted by name.
+func (r *TagRules) Names() []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(tags map[string]string) {
+		for name := range tags {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	for _, rule := range r.Rules {
+		add(rule.Tags)
+	}
+	add(r.Defaults)
+	sort.Strings(names)
+	return names
+}
+
+// TagDevices returns the tags for each of the devices.
+func (r *TagRules) TagDevices(devices []Device) DeviceTags {
+	t := make(DeviceTags, len(devices))
+	for i := range devices {
+		t[devices[i].GetDeviceId()] = r.Tags(&devices[i])
+	}
+	return t
+}
+
+// ApplyTagRules derives the Tags of the devices in the results using the rules.
+func (r *BulkResults) ApplyTagRules(rules *TagRules) {
+	r.Tags = rules.TagDevices(r.Devices)
+}
+
+// DeviceTags holds the tags derived for each device, keyed by the DeviceId.
+type DeviceTags map[int]map[string]string
+
+// Tag returns the value of the named tag for the device, or an empty string where it has none.
+func (t DeviceTags) Tag(d *Device, name string) string {
+	return t[d.GetDeviceId()][name]
+}
+
+// Group returns the devices grouped by the value of the named tag, with any devices without the
+// tag under an empty string.  This allows any of the reports which take devices, such as
+// AuditDevices or TrackComplianceReport, to be run for each site, team or business unit.
+func (t DeviceTags) Group(devices []Device, name string) map[string][]Device {
+	groups := make(map[string][]Device)
+	for i := range devices {
+		value := t.Tag(&devices[i], name)
+		groups[value] = append(groups[value], devices[i])
+	}
+	return groups
+}
+
+// GroupBy groups the devices by the given fields, as GroupBy, where the fields can also be tags,
+// identified by TagFieldPrefix, e.g. tag:site.
+func (t DeviceTags) GroupBy(devices []Device, fields ...string) (*GroupStats, error) {
+	return groupBy(devices, fields, t)
+}
@@ -0,0 +1,122 @@
+package ciscobcs
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const testTagRules = `
+rules:
+  - sysLocation: '^(?P<site>[A-Z]{3})\d*-'
+    tags: {site: '${site}'}
+  - deviceNamePrefix: LON-
+    tags: {site: LON}
+  - userFields: {userField1: '^(?i)netops$', UserField2: 'core'}
+    tags: {team: NetOps, businessUnit: Infrastructure}
+  - sysLocation: 'Lab'
+    tags: {team: Lab}
+defaults:
+  site: Unknown
+`
+
+func TestTagRules(t *testing.T) {
+	rules, err := ParseTagRules([]byte(testTagRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	devices := []Device{
+		{DeviceId: Int(1), DeviceName: String("lon-rtr-01"), SysLocation: String("RTP6-Lab"), UserField1: String("NETOPS"), UserField2: String("dc-core")},
+		{DeviceId: Int(2), DeviceName: String("lon-rtr-02"), UserField1: String("netops")},
+		{DeviceId: Int(3), DeviceName: String("par-sw-01"), SysLocation: String("ECATS Lab RTP")},
+	}
+	want := []map[string]string{
+		{"site": "RTP", "team": "NetOps", "businessUnit": "Infrastructure"},
+		{"site": "LON"},
+		{"site": "Unknown", "team": "Lab"},
+	}
+	for i, d := range devices {
+		if got := rules.Tags(&d); !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("device %d: got %v; want %v", d.GetDeviceId(), got, want[i])
+		}
+	}
+	if got, want := rules.Names(), []string{"businessUnit", "site", "team"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+
+	rulesJSON := `{"rules":[{"deviceNamePrefix":"lon-","tags":{"site":"LON"}}]}`
+	if r, err := ParseTagRules([]byte(rulesJSON)); err != nil || r.Tags(&devices[2])["site"] != "" || r.Tags(&devices[1])["site"] != "LON" {
+		t.Errorf("got %v; want json rules", err)
+	}
+	for _, s := range []string{
+		`rules: [{sysLocation: '(', tags: {site: a}}]`,
+		`rules: [{userFields: {userField5: a}, tags: {site: a}}]`,
+		`rules: [{deviceNamePrefix: a}]`,
+		`rules: [{devicePrefix: a, tags: {site: a}}]`,
+		`rules: {`,
+	} {
+		if _, err := ParseTagRules([]byte(s)); !errors.Is(err, ErrInvalidTagRules) {
+			t.Errorf("%s: got %v; want %v", s, err, ErrInvalidTagRules)
+		}
+	}
+
+	tags := rules.TagDevices(devices)
+	groups := tags.Group(devices, "team")
+	if len(groups) != 3 || len(groups[""]) != 1 || groups[""][0].GetDeviceId() != 2 {
+		t.Errorf("got groups %v; want NetOps, Lab and none", groups)
+	}
+	s, err := tags.GroupBy(devices, "tag:site", "deviceName")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Groups) != 3 || s.Groups[0].Values[0] != "LON" {
+		t.Errorf("got %+v; want grouped by site", s.Groups)
+	}
+	if _, err := GroupBy(devices, "tag:site"); !errors.Is(err, ErrUnknownGroupField) {
+		t.Errorf("got %v; want %v without tags", err, ErrUnknownGroupField)
+	}
+
+	var buf bytes.Buffer
+	cw := NewCSVWriter(&buf, NewMask("deviceName"))
+	cw.SetTags(tags, "site", "team")
+	for _, d := range devices {
+		if err := cw.Write(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]string{{"deviceName", "tag:site", "tag:team"}, {"lon-rtr-01", "RTP", "NetOps"}, {"lon-rtr-02", "LON", ""}, {"par-sw-01", "Unknown", "Lab"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("got %v; want %v", rows, want)
+	}
+
+	t.Run("demo", func(t *testing.T) {
+		results := demoResults(t)
+		results.ApplyTagRules(rules)
+		s, err := results.Stats("device", "tag:team")
+		if err != nil {
+			t.Fatal(err)
+		}
+		labs := 0
+		for _, d := range results.Devices {
+			if bytes.Contains([]byte(d.GetSysLocation()), []byte("Lab")) {
+				labs++
+			}
+		}
+		for _, g := range s.Groups {
+			if g.Values[0] == "Lab" && g.Count != labs {
+				t.Errorf("got %v lab devices; want %v", g.Count, labs)
+			}
+		}
+		if labs == 0 || len(results.Tags) != len(results.Devices) {
+			t.Errorf("got %v lab devices, %v tagged; want some of %v", labs, len(results.Tags), len(results.Devices))
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package ciscobcs
+
+import (
+	"math"
+	"strings"
+
+	"github.com/darrenparkinson/bcs/pkg/swversion"
+)
+
+// TrackComplianceStatus represents how a device complies with the recommended versions of its
+// software track.
+type TrackComplianceStatus int
+
+// Track compliance statuses, from least to most compliant.
+const (
+	TrackNonCompliant TrackComplianceStatus = iota
+	TrackPrevious2
+	TrackPrevious1
+	TrackStandard
+)
+
+var trackComplianceNames = map[TrackComplianceStatus]string{
+	TrackNonCompliant: "non-compliant",
+	TrackPrevious2:    "prev2",
+	TrackPrevious1:    "prev1",
+	TrackStandard:     "standard",
+}
+
+// String returns the name of the status, e.g. "prev1".
+func (s TrackComplianceStatus) String() string {
+	if name, ok := trackComplianceNames[s]; ok {
+		return name
+	}
+	return trackComplianceNames[TrackNonCompliant]
+}
+
+// MarshalText will marshal the status using its name, e.g. "standard".
+func (s TrackComplianceStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Flexible reports whether the status counts towards flexible compliance, i.e. the device is
+// running the standard version or one of the two previous versions.
+func (s TrackComplianceStatus) Flexible() bool {
+	return s != TrackNonCompliant
+}
+
+// TrackMemberFunc reports whether the device belongs to the software track.
+type TrackMemberFunc func(t *TrackSummary, d *Device) bool
+
+// SameTrackSwType is a TrackMemberFunc which treats every device with the same SwType as the
+// track as a member, ignoring case and any space or hyphen.  It is only accurate where each
+// software type has a single track, since the bulk results do not record the track of a device.
+func SameTrackSwType(t *TrackSummary, d *Device) bool {
+	return swversion.SameType(t.GetSwType(), d.GetSwType())
+}
+
+// TrackMembers returns a TrackMemberFunc using the device membership reported by the
+// SoftwareTrackService.GetCompliance method for each of the tracks.
+func TrackMembers(compliance []TrackCompliance) TrackMemberFunc {
+	members := make(map[int]map[int]bool)
+	for i := range compliance {
+		id := compliance[i].GetTrackId()
+		if members[id] == nil {
+			members[id] = make(map[int]bool)
+		}
+		members[id][compliance[i].GetDeviceId()] = true
+	}
+	return func(t *TrackSummary, d *Device) bool {
+		return members[t.GetTrackId()][d.GetDeviceId()]
+	}
+}
+
+// TrackDeviceCompliance holds the compliance status of a device in a software track.
+type TrackDeviceCompliance struct {
+	Device Device
+	Status TrackComplianceStatus
+}
+
+// TrackMismatch describes a figure reported by Cisco for a software track which differs from
+// that computed from the devices.
+type TrackMismatch struct {
+	// Field is the json name of the field in the track summary, e.g. trackCompliantDevices.
+	Field string
+
+	Reported float64
+	Computed float64
+}
+
+// TrackComplianceResult holds the compliance of the devices in a software track, recomputed
+// from the devices rather than taken from the track summary.
+type TrackComplianceResult struct {
+	Track   TrackSummary
+	Devices []TrackDeviceCompliance
+
+	TotalDevices         int
+	CompliantDevices     int
+	PrevCompliantDevices int
+	NonCompliantDevices  int
+
+	// PercentCompliant is the percentage of devices running the standard version.
+	PercentCompliant float32
+
+	// PercentFlexibleCompliant is the percentage of devices running the standard version or
+	// one of the two previous versions.
+	PercentFlexibleCompliant float32
+
+	// Mismatches holds the figures in the track summary which differ from those computed.
+	Mismatches []TrackMismatch
+}
+
+// TrackCompliance classifies the devices in the results against each of the software tracks in
+// the results.  See the TrackComplianceReport function for details.
+func (r *BulkResults) TrackCompliance(member TrackMemberFunc) []TrackComplianceResult {
+	return TrackComplianceReport(r.TrackSummaries, r.Devices, member)
+}
+
+// TrackComplianceReport classifies each device belonging to each track as running the
+// standard, previous-1 or previous-2 version of the track, or as non-compliant, and recomputes
+// the compliance figures for the track.  Membership is determined by member, which defaults to
+// SameTrackSwType.  Versions are compared using the swversion package, so 16.6(5) is the same as
+// 16.6.5, falling back to a comparison ignoring case where they cannot be parsed.
+//
+// The figures are compared with those reported by Cisco and any differences recorded as
+// mismatches.  As the track summary gives percentages as whole numbers, a percentage only
+// differs where it is more than 0.5 away from that computed.
+func TrackComplianceReport(tracks []TrackSummary, devices []Device, member TrackMemberFunc) []TrackComplianceResult {
+	if member == nil {
+		member = SameTrackSwType
+	}
+	var report []TrackComplianceResult
+	for i := range tracks {
+		t := &tracks[i]
+		res := TrackComplianceResult{Track: *t}
+		for j := range devices {
+			if !member(t, &devices[j]) {
+				continue
+			}
+			status := TrackDeviceStatus(t, &devices[j])
+			res.Devices = append(res.Devices, TrackDeviceCompliance{Device: devices[j], Status: status})
+			switch status {
+			case TrackStandard:
+				res.CompliantDevices++
+			case TrackPrevious1, TrackPrevious2:
+				res.PrevCompliantDevices++
+			}
+		}
+		res.TotalDevices = len(res.Devices)
+		res.NonCompliantDevices = res.TotalDevices - res.CompliantDevices
+		if res.TotalDevices > 0 {
+			res.PercentCompliant = float32(100 * float64(res.CompliantDevices) / float64(res.TotalDevices))
+			res.PercentFlexibleCompliant = float32(100 * float64(res.CompliantDevices+res.PrevCompliantDevices) / float64(res.TotalDevices))
+		}
+		res.Mismatches = trackMismatches(t, &res)
+		report = append(report, res)
+	}
+	return report
+}
+
+// TrackDeviceStatus returns the compliance status of the device against the recommended
+// versions of the track, regardless of whether the device belongs to the track.
+func TrackDeviceStatus(t *TrackSummary, d *Device) TrackComplianceStatus {
+	switch {
+	case sameSwVersion(t.GetSwType(), t.GetTrackStandardSwVersion(), d.GetSwVersion()):
+		return TrackStandard
+	case sameSwVersion(t.GetSwType(), t.GetTrackPrevSwVersion1(), d.GetSwVersion()):
+		return TrackPrevious1
+	case sameSwVersion(t.GetSwType(), t.GetTrackPrevSwVersion2(), d.GetSwVersion()):
+		return TrackPrevious2
+	}
+	return TrackNonCompliant
+}
+
+// sameSwVersion reports whether the recommended version a and the device version b are the
+// same, where neither is a sentinel.
+func sameSwVersion(swType, a, b string) bool {
+	if IsSentinel(a) || IsSentinel(b) {
+		return false
+	}
+	if c, err := swversion.Compare(swType, a, b); err == nil {
+		return c == 0
+	}
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+// trackMismatches returns the figures in the track summary which differ from those computed.
+func trackMismatches(t *TrackSummary, res *TrackComplianceResult) []TrackMismatch {
+	var mismatches []TrackMismatch
+	counts := []struct {
+		field    string
+		reported *int
+		computed int
+	}{
+		{"trackTotalDevices", t.TrackTotalDevices, res.TotalDevices},
+		{"trackCompliantDevices", t.TrackCompliantDevices, res.CompliantDevices},
+		{"trackPrevCompliantDevices", t.TrackPrevCompliantDevices, res.PrevCompliantDevices},
+		{"trackNonCompliantDevices", t.TrackNonCompliantDevices, res.NonCompliantDevices},
+	}
+	for _, c := range counts {
+		if c.reported != nil && *c.reported != c.computed {
+			mismatches = append(mismatches, TrackMismatch{Field: c.field, Reported: float64(*c.reported), Computed: float64(c.computed)})
+		}
+	}
+	percents := []struct {
+		field    string
+		reported *float32
+		computed float32
+	}{
+		{"trackPercentCompliant", t.TrackPercentCompliant, res.PercentCompliant},
+		{"trackPercentFlexibleCompliant", t.TrackPercentFlexibleCompliant, res.PercentFlexibleCompliant},
+	}
+	for _, p := range percents {
+		if p.reported != nil && math.Abs(float64(*p.reported-p.computed)) > 0.5 {
+			mismatches = append(mismatches, TrackMismatch{Field: p.field, Reported: float64(*p.reported), Computed: float64(p.computed)})
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,97 @@
+package ciscobcs
+
+import (
+	"testing"
+)
+
+func TestTrackCompliance(t *testing.T) {
+	tracks := []TrackSummary{
+		{
+			TrackId:                       Int(1),
+			SwType:                        String("NX-OS"),
+			TrackStandardSwVersion:        String("7.3(2)N1(1)"),
+			TrackPrevSwVersion1:           String("5.2(1)N1(9)"),
+			TrackPrevSwVersion2:           String("null"),
+			TrackTotalDevices:             Int(4),
+			TrackCompliantDevices:         Int(1),
+			TrackPrevCompliantDevices:     Int(1),
+			TrackNonCompliantDevices:      Int(3),
+			TrackPercentCompliant:         Float32(25),
+			TrackPercentFlexibleCompliant: Float32(75),
+		},
+	}
+	devices := []Device{
+		{DeviceId: Int(1), SwType: String("NX-OS"), SwVersion: String("7.3(2)N1(1)")},
+		{DeviceId: Int(2), SwType: String("NX-OS"), SwVersion: String("5.2(1)n1(9)")},
+		{DeviceId: Int(3), SwType: String("NX-OS"), SwVersion: String("5.2(1)N1(9)")},
+		{DeviceId: Int(4), SwType: String("NX-OS"), SwVersion: String("Not Found")},
+		{DeviceId: Int(5), SwType: String("IOS"), SwVersion: String("7.3(2)N1(1)")},
+	}
+	report := TrackComplianceReport(tracks, devices, nil)
+	if len(report) != 1 {
+		t.Fatalf("got %v tracks; want 1", len(report))
+	}
+	got := report[0]
+	want := []TrackComplianceStatus{TrackStandard, TrackPrevious1, TrackPrevious1, TrackNonCompliant}
+	if len(got.Devices) != len(want) {
+		t.Fatalf("got %v devices; want %v", len(got.Devices), len(want))
+	}
+	for i, d := range got.Devices {
+		if d.Status != want[i] {
+			t.Errorf("device %v: got %v; want %v", d.Device.GetDeviceId(), d.Status, want[i])
+		}
+	}
+	if got.PercentCompliant != 25 || got.PercentFlexibleCompliant != 75 {
+		t.Errorf("got %v%%, %v%% compliant; want 25%%, 75%%", got.PercentCompliant, got.PercentFlexibleCompliant)
+	}
+	if len(got.Mismatches) != 1 || got.Mismatches[0].Field != "trackPrevCompliantDevices" || got.Mismatches[0].Computed != 2 {
+		t.Errorf("got mismatches %+v; want trackPrevCompliantDevices only", got.Mismatches)
+	}
+
+	t.Run("demo", func(t *testing.T) {
+		results := demoResults(t)
+		extras := demoDeviceExtras(t)
+		member := func(t *TrackSummary, d *Device) bool {
+			for _, st := range extras[d.GetDeviceId()].SoftwareTracks {
+				if st.TrackId == t.GetTrackId() {
+					return true
+				}
+			}
+			return false
+		}
+		mismatched := make(map[int][]string)
+		for _, res := range results.TrackCompliance(member) {
+			for _, d := range res.Devices {
+				for _, st := range extras[d.Device.GetDeviceId()].SoftwareTracks {
+					if st.TrackId != res.Track.GetTrackId() {
+						continue
+					}
+					if st.TrackCompliant != (d.Status == TrackStandard) || st.TrackPreviousCompliant != (d.Status == TrackPrevious1 || d.Status == TrackPrevious2) {
+						t.Errorf("track %v device %v %s: got %v; want compliant %v, previous %v",
+							res.Track.GetTrackId(), d.Device.GetDeviceId(), d.Device.GetSwVersion(), d.Status, st.TrackCompliant, st.TrackPreviousCompliant)
+					}
+				}
+			}
+			for _, m := range res.Mismatches {
+				mismatched[res.Track.GetTrackId()] = append(mismatched[res.Track.GetTrackId()], m.Field)
+			}
+		}
+		// The trackPrevCompliantDevices reported in the demo data is always the same as
+		// trackCompliantDevices, rather than the number of devices on the previous versions,
+		// although the flexible compliance percentages agree with the devices.
+		want := map[int]string{
+			300594: "trackPrevCompliantDevices",
+			339531: "trackPrevCompliantDevices",
+			339544: "trackPrevCompliantDevices",
+			339561: "trackPrevCompliantDevices",
+		}
+		if len(mismatched) != len(want) {
+			t.Errorf("got mismatches %v; want %v", mismatched, want)
+		}
+		for id, field := range want {
+			if got := mismatched[id]; len(got) != 1 || got[0] != field {
+				t.Errorf("track %v: got mismatches %v; want %v", id, got, field)
+			}
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package ciscobcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// SchemaWarning describes a difference between a response from the API and the API specification,
+// such as a field whose type has changed.
+type SchemaWarning struct {
+	// Method is the HTTP method of the request, e.g. GET.
+	Method string
+
+	// Path is the path of the matching operation in the specification, e.g. /customer/{customerid}/inventory/devices.
+	// It is the request path where no operation matched.
+	Path string
+
+	// Pointer is the JSON pointer to the value in the response body, e.g. /items/0/deviceId.
+	Pointer string
+
+	// Message describes the difference.
+	Message string
+}
+
+// String returns the warning in a form suitable for logging.
+func (w SchemaWarning) String() string {
+	return fmt.Sprintf("ciscobcs: schema warning: %s %s: %q: %s", w.Method, w.Path, w.Pointer, w.Message)
+}
+
+// ResponseValidator validates response bodies against the operations in the API specification, such
+// as cmd/convert-swagger-to-openapi/openapi.json.  It is intended to catch changes to the API, which
+// can otherwise result in fields silently failing to parse, and is used by the client when set as its
+// Validator.
+type ResponseValidator struct {
+	// Warn is called with each difference found when the client validates a response.  Defaults to
+	// logging the warning using the standard logger.
+	Warn func(SchemaWarning)
+
+	routes []route
+}
+
+// route holds an operation path from the specification, split into its segments.
+type route struct {
+	path     string
+	segments []string
+	item     *openapi3.PathItem
+}
+
+// NewResponseValidator returns a validator for the given specification, whose references must have
+// been resolved, e.g. using an openapi3.Loader.
+func NewResponseValidator(doc *openapi3.T) *ResponseValidator {
+	v := &ResponseValidator{}
+	for path, item := range doc.Paths {
+		v.routes = append(v.routes, route{
+			path:     path,
+			segments: strings.Split(strings.Trim(path, "/"), "/"),
+			item:     item,
+		})
+	}
+	return v
+}
+
+// LoadResponseValidator loads the specification in filename and returns a validator for it.
+func LoadResponseValidator(filename string) (*ResponseValidator, error) {
+	doc, err := openapi3.NewLoader().LoadFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return NewResponseValidator(doc), nil
+}
+
+// Validate validates the response body for a request with the given method and URL path against
+// the matching operation in the specification and returns any differences found.  The path may
+// include a prefix, such as /v2, before the path of the operation.  Responses without a schema in
+// the specification are not validated.
+func (v *ResponseValidator) Validate(method, path string, status int, body []byte) []SchemaWarning {
+	r, ok := v.match(path)
+	if !ok {
+		return []SchemaWarning{{Method: method, Path: path, Message: "no matching path in specification"}}
+	}
+	op := r.item.GetOperation(method)
+	if op == nil {
+		return []SchemaWarning{{Method: method, Path: r.path, Message: "no matching operation in specification"}}
+	}
+	res := op.Responses.Get(status)
+	if res == nil {
+		res = op.Responses.Default()
+	}
+	if res == nil || res.Value == nil {
+		return []SchemaWarning{{Method: method, Path: r.path, Message: fmt.Sprintf("undocumented response status %d", status)}}
+	}
+	content := res.Value.Content.Get("application/json")
+	if content == nil || content.Schema == nil || content.Schema.Value == nil {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return []SchemaWarning{{Method: method, Path: r.path, Message: err.Error()}}
+	}
+	var warnings []SchemaWarning
+	err := content.Schema.Value.VisitJSON(value, openapi3.MultiErrors(), openapi3.VisitAsResponse())
+	schemaErrors(err, nil, func(pointer []string, message string) {
+		warnings = append(warnings, SchemaWarning{Method: method, Path: r.path, Pointer: jsonPointer(pointer), Message: message})
+	})
+	return warnings
+}
+
+// validate validates the response body for the request, calling Warn with any differences found.
+func (v *ResponseValidator) validate(req *http.Request, status int, body []byte) {
+	warn := v.Warn
+	if warn == nil {
+		warn = func(w SchemaWarning) { log.Println(w) }
+	}
+	for _, w := range v.Validate(req.Method, req.URL.Path, status, body) {
+		warn(w)
+	}
+}
+
+// match returns the route whose path matches the end of the request path.  Literal segments are
+// preferred over parameters where more than one route matches.
+func (v *ResponseValidator) match(path string) (route, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var best route
+	bestLiterals := -1
+	for _, r := range v.routes {
+		if len(r.segments) > len(segments) {
+			continue
+		}
+		tail := segments[len(segments)-len(r.segments):]
+		literals := 0
+		matched := true
+		for i, s := range r.segments {
+			if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+				continue
+			}
+			if s != tail[i] {
+				matched = false
+				break
+			}
+			literals++
+		}
+		if matched && literals > bestLiterals {
+			best, bestLiterals = r, literals
+		}
+	}
+	return best, bestLiterals >= 0
+}
+
+// schemaErrors calls add with the JSON pointer and reason for each of the schema errors in err.
+// Errors from composed schemas, e.g. allOf, are unwrapped to report the underlying difference.
+func schemaErrors(err error, prefix []string, add func(pointer []string, message string)) {
+	switch e := err.(type) {
+	case nil:
+	case openapi3.MultiError:
+		for _, err := range e {
+			schemaErrors(err, prefix, add)
+		}
+	case *openapi3.SchemaError:
+		pointer := append(append([]string(nil), prefix...), e.JSONPointer()...)
+		if e.Origin != nil {
+			schemaErrors(e.Origin, pointer, add)
+			return
+		}
+		add(pointer, e.Reason)
+	default:
+		add(prefix, err.Error())
+	}
+}
+
+// jsonPointer returns the RFC 6901 JSON pointer for the path, e.g. /items/0/deviceId.
+func jsonPointer(path []string) string {
+	var b strings.Builder
+	for _, p := range path {
+		b.WriteString("/")
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(p))
+	}
+	return b.String()
+}
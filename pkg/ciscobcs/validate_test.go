@@ -0,0 +1,58 @@
+package ciscobcs
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+)
+
+func TestResponseValidator(t *testing.T) {
+	v, err := LoadResponseValidator("../../cmd/convert-swagger-to-openapi/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("client", func(t *testing.T) {
+		c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"page":1,"pages":1,"perPage":500,"total":2,"items":[` +
+				`{"deviceId":1,"deviceName":"router1","inSeedFile":"yes"},` +
+				`{"deviceId":"2","deviceName":"router2","configTime":"2021-08-01T09:00:00"}]}`))
+		})
+		var warnings []SchemaWarning
+		v.Warn = func(w SchemaWarning) { warnings = append(warnings, w) }
+		c.Validator = v
+		page, err := c.InventoryService.ListDevices(context.Background(), "12345", nil)
+		if err == nil {
+			t.Errorf("expected unmarshal error for string deviceId, got page %+v", page)
+		}
+		var pointers []string
+		for _, w := range warnings {
+			if w.Path != "/customer/{customerid}/inventory/devices" {
+				t.Errorf("got path %v for %v", w.Path, w)
+			}
+			pointers = append(pointers, w.Pointer)
+		}
+		sort.Strings(pointers)
+		if len(pointers) != 2 || pointers[0] != "/items/0/inSeedFile" || pointers[1] != "/items/1/deviceId" {
+			t.Errorf("got warnings %v; want /items/0/inSeedFile and /items/1/deviceId", warnings)
+		}
+	})
+	t.Run("valid", func(t *testing.T) {
+		if got := v.Validate("GET", "/v2/customer/12345/inventory/devices/count", 200, []byte(`300`)); len(got) != 0 {
+			t.Errorf("got %v; want no warnings", got)
+		}
+		if got := v.Validate("GET", "/v2/customer/12345/productAlerts/fnBulletins", 200, []byte(`{"items":[{"fieldNoticeId":"63743"}]}`)); len(got) != 0 {
+			t.Errorf("got %v; want no warnings", got)
+		}
+	})
+	t.Run("unknown", func(t *testing.T) {
+		got := v.Validate("GET", "/v2/customer/12345/unknown", 200, []byte(`{}`))
+		if len(got) != 1 || got[0].Message != "no matching path in specification" {
+			t.Errorf("got %v; want no matching path", got)
+		}
+		got = v.Validate("GET", "/v2/customer/12345/inventory/devices/count", 404, []byte(`{}`))
+		if len(got) != 1 || got[0].Message != "undocumented response status 404" {
+			t.Errorf("got %v; want undocumented response status", got)
+		}
+	})
+}
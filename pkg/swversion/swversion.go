@@ -0,0 +1,280 @@
+// Package swversion parses and compares Cisco software version strings, such as those found in
+// the swVersion field of a device or the recommended versions of a software track.
+//
+// Versions are parsed according to their software type, e.g. IOS, IOS-XE, IOS XR or NX-OS, so
+// that the major version, maintenance version and train can be extracted in the same form used
+// by the Cisco software end-of-life bulletins.  Any version can be compared with another of the
+// same software type.
+package swversion
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Err implements the error interface so we can have constant errors.
+type Err string
+
+func (e Err) Error() string {
+	return string(e)
+}
+
+// Error Constants
+const (
+	ErrInvalidVersion = Err("swversion: invalid software version")
+)
+
+// Software types with specific version formats.  Types are matched case insensitively and
+// ignoring any space or hyphen, so "IOS XR" and "IOS-XR" are equivalent.
+const (
+	TypeIOS   = "IOS"
+	TypeIOSXE = "IOS-XE"
+	TypeIOSXR = "IOS XR"
+	TypeNXOS  = "NX-OS"
+)
+
+var (
+	// 12.2(55)SE2, 15.0(2)SG8, 12.2(33)SXI5, 12.4(15)T10
+	iosPattern = regexp.MustCompile(`^(\d+\.\d+)\((\d+[a-z]?)\)([A-Z]*)(\d*[a-z]?)$`)
+	// 16.9.4, 16.12.3s, 3.7.3S, 3.7S
+	iosXEPattern = regexp.MustCompile(`^(\d+\.\d+)(?:\.(\d+))?([A-Z]*)([a-z]*)$`)
+	// 9.3(5), 5.2(6b), 5.2(1)N1(9), 7.0(3)I4(7)
+	nxosPattern = regexp.MustCompile(`^(\d+\.\d+)\((\d+[a-z]?)\)(?:([A-Z]+\d*)\((\d+[a-z]?)\))?$`)
+	// 6.1.3, 6.1.22.23, 8.5.171.0
+	dottedPattern = regexp.MustCompile(`^(\d+\.\d+)(?:\.(\d+))?((?:\.\d+)*)$`)
+)
+
+// Version represents a parsed Cisco software version.
+type Version struct {
+	// Type is the software type the version was parsed as, e.g. IOS.
+	Type string
+
+	// Major is the major version, e.g. "12.2" for 12.2(55)SE2 or "16.9" for 16.9.4.
+	Major string
+
+	// Maintenance is the maintenance version, e.g. "55" for 12.2(55)SE2 or "4" for 16.9.4.
+	Maintenance string
+
+	// Train is the software train, e.g. "SE" for 12.2(55)SE2, "S" for 3.7.3S or "N1" for 5.2(1)N1(9).
+	Train string
+
+	// Rebuild is the remainder of the version following the train, e.g. "2" for 12.2(55)SE2.
+	Rebuild string
+
+	raw    string
+	tokens []token
+}
+
+// Parse parses the version string s according to the given software type.  Types without a
+// specific format are parsed as dotted versions where possible, and otherwise are only
+// available for comparison.  Values such as "Not Found" return ErrInvalidVersion.
+func Parse(swType, s string) (Version, error) {
+	s = strings.TrimSpace(s)
+	v := Version{Type: swType, raw: s, tokens: tokenize(s)}
+	if len(v.tokens) == 0 || !v.tokens[0].numeric {
+		return Version{}, ErrInvalidVersion
+	}
+	var m []string
+	switch normalizeType(swType) {
+	case "IOS":
+		m = iosPattern.FindStringSubmatch(s)
+	case "IOSXE":
+		if m = iosXEPattern.FindStringSubmatch(s); m != nil {
+			// uppercase letters are the train, e.g. 3.7.3S, lowercase a rebuild, e.g. 16.12.3s
+			v.Major, v.Maintenance, v.Train, v.Rebuild = m[1], m[2], m[3], m[4]
+			return v, nil
+		}
+	case "NXOS":
+		m = nxosPattern.FindStringSubmatch(s)
+	default:
+		if m = dottedPattern.FindStringSubmatch(s); m != nil {
+			v.Major, v.Maintenance, v.Rebuild = m[1], m[2], strings.TrimPrefix(m[3], ".")
+			return v, nil
+		}
+		// many other platforms, e.g. APIC and UCS, follow the NX-OS format
+		m = nxosPattern.FindStringSubmatch(s)
+	}
+	if m != nil {
+		v.Major, v.Maintenance, v.Train, v.Rebuild = m[1], m[2], m[3], m[4]
+		return v, nil
+	}
+	// fall back to the leading major version for any other format
+	v.Major = strconv.Itoa(v.tokens[0].num)
+	if len(v.tokens) > 1 && v.tokens[1].numeric {
+		v.Major += "." + strconv.Itoa(v.tokens[1].num)
+	}
+	return v, nil
+}
+
+// MustParse is like Parse but panics if the version cannot be parsed.  It simplifies
+// initialisation of known versions, e.g. in tests.
+func MustParse(swType, s string) Version {
+	v, err := Parse(swType, s)
+	if err != nil {
+		panic(`swversion: Parse(` + strconv.Quote(swType) + `, ` + strconv.Quote(s) + `): ` + err.Error())
+	}
+	return v
+}
+
+// String returns the version as it was originally provided.
+func (v Version) String() string {
+	return v.raw
+}
+
+// IsZero reports whether v is the zero Version, e.g. following a failed Parse.
+func (v Version) IsZero() bool {
+	return v.raw == ""
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater
+// than o.  Versions are compared component by component, with numbers compared numerically
+// and letters compared case insensitively, and a version which is a prefix of another is the
+// lesser, e.g. 12.2(55)SE < 12.2(55)SE2.  Comparisons are only meaningful between versions of
+// the same software type, and between trains only within the same major version.
+func (v Version) Compare(o Version) int {
+	for i := 0; i < len(v.tokens) && i < len(o.tokens); i++ {
+		if c := v.tokens[i].compare(o.tokens[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.tokens) < len(o.tokens):
+		return -1
+	case len(v.tokens) > len(o.tokens):
+		return 1
+	}
+	return 0
+}
+
+// Less reports whether v is less than o.
+func (v Version) Less(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+// Equal reports whether v and o represent the same version.
+func (v Version) Equal(o Version) bool {
+	return v.Compare(o) == 0
+}
+
+// HasPrefix reports whether the leading components of the version are those of prefix, e.g.
+// 16.12.3s has the prefix 16.12, but not 16.1, and 6.0(2)U1(2) has the prefix 6.0(2)U1.  IOS XR
+// releases with a two digit third component belong to the release of its first digit, so
+// 6.1.22 and 6.1.22.23 also have the prefix 6.1.2.
+func (v Version) HasPrefix(prefix string) bool {
+	p := tokenize(prefix)
+	if len(p) == 0 {
+		return false
+	}
+	if hasTokenPrefix(v.tokens, p) {
+		return true
+	}
+	if normalizeType(v.Type) == "IOSXR" && len(v.Maintenance) == 2 {
+		return hasTokenPrefix(tokenize(v.Major+"."+v.Maintenance[:1]), p)
+	}
+	return false
+}
+
+// hasTokenPrefix reports whether the tokens start with those of the prefix.
+func hasTokenPrefix(tokens, prefix []token) bool {
+	if len(prefix) > len(tokens) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i].compare(tokens[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SameType reports whether a and b are the same software type, ignoring case and any space or
+// hyphen, e.g. "IOS XR" and "IOS-XR", or "AireOS" and "AIREOS".
+func SameType(a, b string) bool {
+	return normalizeType(a) == normalizeType(b)
+}
+
+// Compare parses both versions using the given software type and compares them as with Version.Compare.
+func Compare(swType, a, b string) (int, error) {
+	va, err := Parse(swType, a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := Parse(swType, b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb), nil
+}
+
+// normalizeType returns the software type in upper case without spaces or hyphens.
+func normalizeType(swType string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToUpper(swType))
+}
+
+// token is a single numeric or alphabetic component of a version.
+type token struct {
+	numeric bool
+	num     int
+	str     string
+}
+
+// compare compares two tokens, with numbers ordered before letters.
+func (t token) compare(o token) int {
+	switch {
+	case t.numeric && o.numeric:
+		switch {
+		case t.num < o.num:
+			return -1
+		case t.num > o.num:
+			return 1
+		}
+		return 0
+	case t.numeric:
+		return -1
+	case o.numeric:
+		return 1
+	}
+	return strings.Compare(t.str, o.str)
+}
+
+// tokenize splits a version into its numeric and alphabetic components, ignoring separators.
+func tokenize(s string) []token {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case isDigit(c):
+			j := i
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			n, err := strconv.Atoi(s[i:j])
+			if err != nil {
+				// too large to be a version number, so compare it as a string
+				tokens = append(tokens, token{str: strings.TrimLeft(s[i:j], "0")})
+			} else {
+				tokens = append(tokens, token{numeric: true, num: n})
+			}
+			i = j
+		case isLetter(c):
+			j := i
+			for j < len(s) && isLetter(s[j]) {
+				j++
+			}
+			tokens = append(tokens, token{str: strings.ToLower(s[i:j])})
+			i = j
+		default:
+			i++
+		}
+	}
+	return tokens
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
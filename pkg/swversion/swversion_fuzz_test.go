@@ -0,0 +1,35 @@
+//go:build go1.18
+// +build go1.18
+
+package swversion
+
+import (
+	"testing"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{"12.2(55)SE2", "16.12.3s", "3.7S", "6.1.22.23", "5.2(1)N1(9)", "Not Found", ""} {
+		f.Add("IOS", seed)
+		f.Add("NX-OS", seed)
+	}
+	ref := MustParse("IOS-XE", "16.9.4")
+	f.Fuzz(func(t *testing.T, swType, s string) {
+		v, err := Parse(swType, s)
+		if err != nil {
+			return
+		}
+		if v.Major == "" {
+			t.Errorf("%q: parsed without a major version", s)
+		}
+		if c := v.Compare(v); c != 0 {
+			t.Errorf("%q: compared with itself got %v; want 0", s, c)
+		}
+		if a, b := v.Compare(ref), ref.Compare(v); a != -b {
+			t.Errorf("%q: comparison not antisymmetric: %v and %v", s, a, b)
+		}
+		again, err := Parse(swType, v.String())
+		if err != nil || !again.Equal(v) || again.Major != v.Major || again.Train != v.Train {
+			t.Errorf("%q: did not round trip: %+v, %v", s, again, err)
+		}
+	})
+}
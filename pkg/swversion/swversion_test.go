@@ -0,0 +1,106 @@
+package swversion
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		swType, input                      string
+		major, maintenance, train, rebuild string
+	}{
+		{"IOS", "12.2(55)SE2", "12.2", "55", "SE", "2"},
+		{"IOS", "12.2(50)SE", "12.2", "50", "SE", ""},
+		{"IOS", "12.2(33)SXI5", "12.2", "33", "SXI", "5"},
+		{"IOS", "15.0(2)SG8", "15.0", "2", "SG", "8"},
+		{"IOS-XE", "16.9.4", "16.9", "4", "", ""},
+		{"IOS-XE", "16.12.3s", "16.12", "3", "", "s"},
+		{"IOS-XE", "3.7.3S", "3.7", "3", "S", ""},
+		{"IOS-XE", "3.7S", "3.7", "", "S", ""},
+		{"IOS-XE", "3.3.3SE", "3.3", "3", "SE", ""},
+		{"IOS XR", "6.1.22.23", "6.1", "22", "", "23"},
+		{"IOS-XR", "7.1.15", "7.1", "15", "", ""},
+		{"NX-OS", "9.3(5)", "9.3", "5", "", ""},
+		{"NX-OS", "5.2(1)N1(9)", "5.2", "1", "N1", "9"},
+		{"NX-OS", "7.0(3)I4(7)", "7.0", "3", "I4", "7"},
+		{"NX-OS", "5.2(6b)", "5.2", "6b", "", ""},
+		{"AireOS", "8.5.171.0", "8.5", "171", "", "0"},
+		{"UCS", "2.2(5b)", "2.2", "5b", "", ""},
+	}
+	for _, tc := range tests {
+		v, err := Parse(tc.swType, tc.input)
+		if err != nil {
+			t.Errorf("%s %s: didn't expect error: %v", tc.swType, tc.input, err)
+			continue
+		}
+		if v.Major != tc.major || v.Maintenance != tc.maintenance || v.Train != tc.train || v.Rebuild != tc.rebuild {
+			t.Errorf("%s %s: got %q %q %q %q; want %q %q %q %q", tc.swType, tc.input,
+				v.Major, v.Maintenance, v.Train, v.Rebuild, tc.major, tc.maintenance, tc.train, tc.rebuild)
+		}
+		if v.String() != tc.input {
+			t.Errorf("got %v; want %v", v.String(), tc.input)
+		}
+	}
+	for _, input := range []string{"", "Not Found", "null"} {
+		if _, err := Parse("IOS", input); err != ErrInvalidVersion {
+			t.Errorf("%q: got %v; want %v", input, err, ErrInvalidVersion)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		swType, a, b string
+		want         int
+	}{
+		{"IOS", "12.2(55)SE", "12.2(55)SE2", -1},
+		{"IOS", "12.2(55)SE10", "12.2(55)SE2", 1},
+		{"IOS", "12.2(50)SE", "12.2(55)SE", -1},
+		{"IOS", "15.0(1)M4", "12.2(55)SE2", 1},
+		{"IOS-XE", "16.9.4", "16.12.1", -1},
+		{"IOS-XE", "16.12.3", "16.12.3s", -1},
+		{"IOS-XE", "17.3.3", "17.3.3", 0},
+		{"IOS-XE", "3.7.3S", "3.7.3s", 0},
+		{"IOS XR", "6.1.22", "6.1.3", 1},
+		{"NX-OS", "9.3(5)", "9.3(10)", -1},
+		{"NX-OS", "5.2(1)N1(9)", "5.2(1)N1(10)", -1},
+	}
+	for _, tc := range tests {
+		got, err := Compare(tc.swType, tc.a, tc.b)
+		if err != nil {
+			t.Errorf("didn't expect error: %v", err)
+		}
+		if got != tc.want {
+			t.Errorf("Compare(%s, %s): got %v; want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	tests := []struct {
+		swType, version, prefix string
+		want                    bool
+	}{
+		{"IOS-XE", "16.12.3s", "16.12", true},
+		{"IOS-XE", "16.12.3s", "16.1", false},
+		{"IOS XR", "6.1.22", "6.1.2", true},
+		{"IOS XR", "6.1.22.23", "6.1.2", true},
+		{"IOS XR", "6.1.22", "6.1.22", true},
+		{"IOS XR", "6.1.22", "6.1.3", false},
+		{"IOS XR", "6.1.22", "6.1", true},
+		{"IOS XR", "6.1.3", "6.1.2", false},
+		{"IOS XR", "6.1.2", "6.1.22", false},
+		{"NX-OS", "6.1(22)", "6.1(2)", false},
+		{"NX-OS", "6.0(2)U1(2)", "6.0(2)U1", true},
+		{"NX-OS", "6.0(2)U1(2)", "6.0(2)U2", false},
+		{"IOS", "12.2(55)SE2", "", false},
+	}
+	for _, tc := range tests {
+		if got := MustParse(tc.swType, tc.version).HasPrefix(tc.prefix); got != tc.want {
+			t.Errorf("%s.HasPrefix(%q): got %v; want %v", tc.version, tc.prefix, got, tc.want)
+		}
+	}
+	if !SameType("AireOS", "AIREOS") || !SameType("IOS XR", "ios-xr") || SameType("IOS", "IOS-XE") {
+		t.Errorf("unexpected SameType result")
+	}
+}